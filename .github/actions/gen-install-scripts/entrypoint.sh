@@ -26,23 +26,27 @@ kustomize version
 kustomize build --load-restrictor LoadRestrictionsNone "config/release/${INPUT_ENV}/allinone" > "${target_dir}/all-in-one.yaml"
 echo "Created all-in-one config"
 
+# The CRDs with the conversion webhook pointing to the Operator service, config/crd alone doesn't resolve it
+yq e 'select(.kind == "CustomResourceDefinition")' "${target_dir}/all-in-one.yaml" > "${target_dir}/crds.yaml"
+
 # clusterwide
 kustomize build --load-restrictor LoadRestrictionsNone "config/release/${INPUT_ENV}/clusterwide" > "${clusterwide_dir}/clusterwide-config.yaml"
-kustomize build "config/crd" > "${clusterwide_dir}/crds.yaml"
+cp "${target_dir}/crds.yaml" "${clusterwide_dir}/crds.yaml"
 echo "Created clusterwide config"
 
 # base-openshift-namespace-scoped
 kustomize build --load-restrictor LoadRestrictionsNone "config/release/${INPUT_ENV}/openshift" > "${openshift}/openshift.yaml"
-kustomize build "config/crd" > "${openshift}/crds.yaml"
+cp "${target_dir}/crds.yaml" "${openshift}/crds.yaml"
 echo "Created openshift namespaced config"
 
 # namespaced
 kustomize build --load-restrictor LoadRestrictionsNone "config/release/${INPUT_ENV}/namespaced" > "${namespaced_dir}/namespaced-config.yaml"
-kustomize build "config/crd" > "${namespaced_dir}/crds.yaml"
+cp "${target_dir}/crds.yaml" "${namespaced_dir}/crds.yaml"
 echo "Created namespaced config"
 
 # crds
 cp config/crd/bases/* "${crds_dir}"
+rm "${target_dir}/crds.yaml"
 
 # CSV bundle
 operator-sdk generate kustomize manifests -q --apis-dir=pkg/api
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -39,18 +40,28 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	mdbv2 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v2"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdatafederation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasproject"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/keylock"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/version"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/webhook"
 )
 
 const (
@@ -69,6 +80,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(mdbv1.AddToScheme(scheme))
+	utilruntime.Must(mdbv2.AddToScheme(scheme))
 }
 
 func main() {
@@ -78,17 +90,39 @@ func main() {
 	// logging
 	ctrzap.NewRaw(ctrzap.UseDevMode(true), ctrzap.StacktraceLevel(zap.ErrorLevel))
 	config := parseConfiguration()
-	logger, err := initCustomZapLogger(config.LogLevel, config.LogEncoder)
+	logger, logLevel, err := initCustomZapLogger(config.LogLevel, config.LogEncoder)
 	if err != nil {
 		fmt.Printf("error instantiating logger: %v\r\n", err)
 		os.Exit(1)
 	}
 
+	ctrl.SetLogger(zapr.NewLogger(logger))
+
+	// the settings of the AtlasOperatorConfig override the ones passed with the flags and the environment variables
+	defaultSettings := config.operatorSettings(logLevel.Level())
+	configReader, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create the client reading the operator configuration")
+		os.Exit(1)
+	}
+	settings, watchOperatorConfig := atlasoperatorconfig.Load(context.Background(), configReader, config.OperatorConfigName,
+		defaultSettings, logger.Named("AtlasOperatorConfig").Sugar())
+	config.applyOperatorSettings(settings)
+	logLevel.SetLevel(settings.LogLevel)
+	workflow.ConfigureRetry(settings.Retry)
+	// liveSettings are the settings the reconcilers read on each reconciliation, they're updated by the AtlasOperatorConfig
+	liveSettings := atlasoperatorconfig.NewLiveSettings(settings)
+
 	logger.Info("starting with configuration", zap.Any("config", config), zap.Any("version", version.Version))
 
-	ctrl.SetLogger(zapr.NewLogger(logger))
+	atlas.ConfigureCredentialsDir(config.AtlasCredentialsDir)
+	atlas.ConfigureTransport(config.GlobalAPISecret.Namespace, config.AtlasTransport)
 
-	syncPeriod := time.Hour * 3
+	shutdownTracing, err := tracing.Setup(context.Background(), config.Tracing, version.Version)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
 
 	var cacheFunc cache.NewCacheFunc
 	if len(config.WatchedNamespaces) > 1 {
@@ -117,7 +151,7 @@ func main() {
 		HealthProbeBindAddress: config.ProbeAddr,
 		LeaderElection:         config.EnableLeaderElection,
 		LeaderElectionID:       "06d035fb.mongodb.com",
-		SyncPeriod:             &syncPeriod,
+		SyncPeriod:             &config.SyncPeriod,
 		NewCache:               cacheFunc,
 	})
 	if err != nil {
@@ -125,6 +159,32 @@ func main() {
 		os.Exit(1)
 	}
 
+	// atlasClients is shared by all the controllers to reuse the Atlas clients created from the same Secret.
+	// The requests the clients send are exposed as metrics labelled with the controller.
+	atlasClients := atlas.NewClientRegistry(logger.Named("atlas").Sugar(), config.AtlasRequestsPerSecond, config.AtlasRequestsBurst)
+	// projectLocks serializes the reconciliations changing the same Atlas project
+	projectLocks := keylock.New()
+
+	// operatorCredentialsChanged notifies the AtlasProject controller once the Operator credentials files are rotated
+	var operatorCredentialsChanged chan event.GenericEvent
+	if config.AtlasCredentialsDir != "" {
+		operatorCredentialsChanged = make(chan event.GenericEvent, 1)
+		credentialsLog := logger.Named("atlas").Sugar()
+		err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return atlas.WatchCredentialsDir(ctx, config.AtlasCredentialsDir, credentialsLog, func() {
+				// the pending notification covers the new change as well
+				select {
+				case operatorCredentialsChanged <- event.GenericEvent{Object: &corev1.Secret{}}:
+				default:
+				}
+			})
+		}))
+		if err != nil {
+			setupLog.Error(err, "unable to watch the Atlas API credentials directory")
+			os.Exit(1)
+		}
+	}
+
 	// globalPredicates should be used for general controller Predicates
 	// that should be applied to all controllers in order to limit the
 	// resources they receive events for.
@@ -134,68 +194,95 @@ func main() {
 	}
 
 	if err = (&atlasdeployment.AtlasDeploymentReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		GlobalAPISecret:             config.GlobalAPISecret,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDeployment"),
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:                  mgr.GetClient(),
+		Log:                     logger.Named("controllers").Named("AtlasDeployment").Sugar(),
+		Scheme:                  mgr.GetScheme(),
+		Settings:                liveSettings,
+		AtlasClients:            atlasClients.ForController("AtlasDeployment"),
+		GlobalAPISecret:         config.GlobalAPISecret,
+		GlobalPredicates:        globalPredicates,
+		EventRecorder:           mgr.GetEventRecorderFor("AtlasDeployment"),
+		DriftDetectionInterval:  config.DriftDetectionInterval,
+		MaxConcurrentReconciles: config.Concurrency.Deployment,
+		ProjectLocks:            projectLocks,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDeployment")
 		os.Exit(1)
 	}
 
 	if err = (&atlasproject.AtlasProjectReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasProject").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             config.GlobalAPISecret,
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasProject"),
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:                     mgr.GetClient(),
+		Log:                        logger.Named("controllers").Named("AtlasProject").Sugar(),
+		Scheme:                     mgr.GetScheme(),
+		Settings:                   liveSettings,
+		AtlasClients:               atlasClients.ForController("AtlasProject"),
+		CredentialsChecker:         atlas.NewCredentialsChecker(),
+		GlobalAPISecret:            config.GlobalAPISecret,
+		GlobalPredicates:           globalPredicates,
+		EventRecorder:              mgr.GetEventRecorderFor("AtlasProject"),
+		MaxConcurrentReconciles:    config.Concurrency.Project,
+		OperatorCredentialsChanged: operatorCredentialsChanged,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasProject")
 		os.Exit(1)
 	}
 
 	if err = (&atlasdatabaseuser.AtlasDatabaseUserReconciler{
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		GlobalAPISecret:             config.GlobalAPISecret,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDatabaseUser"),
-		GlobalPredicates:            globalPredicates,
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:                  mgr.GetClient(),
+		Log:                     logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
+		Scheme:                  mgr.GetScheme(),
+		Settings:                liveSettings,
+		AtlasClients:            atlasClients.ForController("AtlasDatabaseUser"),
+		GlobalAPISecret:         config.GlobalAPISecret,
+		EventRecorder:           mgr.GetEventRecorderFor("AtlasDatabaseUser"),
+		GlobalPredicates:        globalPredicates,
+		MaxConcurrentReconciles: config.Concurrency.DatabaseUser,
+		ProjectLocks:            projectLocks,
+		APIReader:               mgr.GetAPIReader(),
+		WatchedNamespaces:       config.WatchedNamespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDatabaseUser")
 		os.Exit(1)
 	}
 
 	if err = (&atlasdatafederation.AtlasDataFederationReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDataFederation").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		GlobalAPISecret:             config.GlobalAPISecret,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDataFederation"),
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:                  mgr.GetClient(),
+		Log:                     logger.Named("controllers").Named("AtlasDataFederation").Sugar(),
+		Scheme:                  mgr.GetScheme(),
+		Settings:                liveSettings,
+		AtlasClients:            atlasClients.ForController("AtlasDataFederation"),
+		GlobalAPISecret:         config.GlobalAPISecret,
+		GlobalPredicates:        globalPredicates,
+		EventRecorder:           mgr.GetEventRecorderFor("AtlasDataFederation"),
+		MaxConcurrentReconciles: config.Concurrency.DataFederation,
+		ProjectLocks:            projectLocks,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDataFederation")
 		os.Exit(1)
 	}
+
+	if watchOperatorConfig {
+		if err = (&atlasoperatorconfig.AtlasOperatorConfigReconciler{
+			Client:        mgr.GetClient(),
+			Log:           logger.Named("controllers").Named("AtlasOperatorConfig").Sugar(),
+			EventRecorder: mgr.GetEventRecorderFor("AtlasOperatorConfig"),
+			Name:          config.OperatorConfigName,
+			Defaults:      defaultSettings,
+			Startup:       settings,
+			LogLevel:      logLevel,
+			Live:          liveSettings,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AtlasOperatorConfig")
+			os.Exit(1)
+		}
+	}
+
+	if config.EnableWebhooks {
+		if err = webhook.Setup(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
@@ -212,34 +299,83 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to flush the traces")
+	}
 }
 
 type Config struct {
 	AtlasDomain                 string
 	EnableLeaderElection        bool
+	EnableWebhooks              bool
 	MetricsAddr                 string
 	Namespace                   string
 	WatchedNamespaces           map[string]bool
 	ProbeAddr                   string
 	GlobalAPISecret             client.ObjectKey
+	AtlasCredentialsDir         string
+	AtlasTransport              *common.TransportRef
+	OperatorConfigName          string
 	LogLevel                    string
 	LogEncoder                  string
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	SyncPeriod                  time.Duration
+	DriftDetectionInterval      time.Duration
+	AtlasRequestsPerSecond      float64
+	AtlasRequestsBurst          int
+	Tracing                     tracing.Config
+	Concurrency                 Concurrency
+}
+
+// Concurrency is the maximum number of the resources of each kind reconciled concurrently
+type Concurrency struct {
+	Project        int
+	Deployment     int
+	DatabaseUser   int
+	DataFederation int
 }
 
 // ParseConfiguration fills the 'OperatorConfig' from the flags passed to the program
 func parseConfiguration() Config {
-	var globalAPISecretName string
+	var globalAPISecretName, operatorConfigName, atlasTransportRef string
 	config := Config{}
 	flag.StringVar(&config.AtlasDomain, "atlas-domain", "https://cloud.mongodb.com/", "the Atlas URL domain name (with slash in the end).")
 	flag.StringVar(&config.MetricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&config.ProbeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&globalAPISecretName, "global-api-secret-name", "", "The name of the Secret that contains Atlas API keys. "+
 		"It is used by the Operator if AtlasProject configuration doesn't contain API key reference. Defaults to <deployment_name>-api-key.")
+	flag.StringVar(&config.AtlasCredentialsDir, "atlas-credentials-dir", "", "The directory with the 'orgId', 'publicApiKey' "+
+		"and 'privateApiKey' files the Operator reads its Atlas API credentials from instead of the global Secret, "+
+		"for example rendered by a Vault agent or a CSI secret store driver. The files are watched for changes.")
+	flag.StringVar(&atlasTransportRef, "atlas-transport-ref", "", "The Secret or the ConfigMap in the Operator namespace "+
+		"with the 'proxyUrl', the 'ca.crt' bundle and the 'tls.crt' / 'tls.key' client certificate used to connect to Atlas, "+
+		"in the format '[Secret|ConfigMap/]<name>'. Overridden by the 'spec.transportRef' of the AtlasProject.")
+	flag.StringVar(&operatorConfigName, "operator-config-name", "", "The name of the cluster-scoped AtlasOperatorConfig "+
+		"overriding the settings of the Operator. Defaults to <deployment_name>.")
 	flag.BoolVar(&config.EnableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&config.EnableWebhooks, "enable-webhooks", false,
+		"Enable the admission and conversion webhooks for Atlas resources. "+
+			"Requires the webhook server certificates to be provisioned, for example by cert-manager.")
+	flag.DurationVar(&config.SyncPeriod, "sync-period", 3*time.Hour, "The minimum interval at which all the watched resources are reconciled.")
+	flag.DurationVar(&config.DriftDetectionInterval, "drift-detection-interval", 0,
+		"The interval at which the deployments are compared with Atlas to detect changes done outside the operator. "+
+			"Defaults to 0, meaning drift is only detected when the resources are reconciled.")
+	flag.Float64Var(&config.AtlasRequestsPerSecond, "atlas-requests-per-second", atlas.DefaultRequestsPerSecond,
+		"The average number of requests per second sent to Atlas for each organization. A non-positive value disables the rate limit.")
+	flag.IntVar(&config.AtlasRequestsBurst, "atlas-requests-burst", atlas.DefaultRequestsBurst,
+		"The maximum number of requests sent to Atlas at once for each organization.")
+	flag.StringVar(&config.Tracing.Endpoint, "otlp-endpoint", "",
+		"The 'host:port' of the OTLP gRPC receiver the traces are exported to. Tracing is disabled if empty.")
+	flag.BoolVar(&config.Tracing.Insecure, "otlp-insecure", false, "Disables the TLS when exporting the traces to the OTLP receiver.")
+	flag.Float64Var(&config.Tracing.SampleRatio, "trace-sample-ratio", 1, "The ratio of the reconciliations traced, between 0 and 1.")
+	flag.IntVar(&config.Concurrency.Project, "atlas-project-concurrency", 1, "The maximum number of the AtlasProjects reconciled concurrently.")
+	flag.IntVar(&config.Concurrency.Deployment, "atlas-deployment-concurrency", 1, "The maximum number of the AtlasDeployments reconciled concurrently.")
+	flag.IntVar(&config.Concurrency.DatabaseUser, "atlas-database-user-concurrency", 1, "The maximum number of the AtlasDatabaseUsers reconciled concurrently.")
+	flag.IntVar(&config.Concurrency.DataFederation, "atlas-data-federation-concurrency", 1, "The maximum number of the AtlasDataFederations reconciled concurrently.")
 	flag.StringVar(&config.LogLevel, "log-level", "info", "Log level. Available values: debug | info | warn | error | dpanic | panic | fatal")
 	flag.StringVar(&config.LogEncoder, "log-encoder", "json", "Log encoder. Available values: json | console")
 	appVersion := flag.Bool("v", false, "prints application version")
@@ -251,6 +387,15 @@ func parseConfiguration() Config {
 	}
 
 	config.GlobalAPISecret = operatorGlobalKeySecretOrDefault(globalAPISecretName)
+	transport, err := atlas.ParseTransportRef(atlasTransportRef)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	config.AtlasTransport = transport
+	config.OperatorConfigName = operatorConfigName
+	if config.OperatorConfigName == "" {
+		config.OperatorConfigName = operatorDeploymentName()
+	}
 
 	// dev note: we pass the watched namespace as the env variable to use the Kubernetes Downward API. Unfortunately
 	// there is no way to use it for container arguments
@@ -273,15 +418,7 @@ func parseConfiguration() Config {
 func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKey {
 	secretName := secretNameOverride
 	if secretName == "" {
-		operatorPodName := os.Getenv("OPERATOR_POD_NAME")
-		if operatorPodName == "" {
-			log.Fatal(`"OPERATOR_POD_NAME" environment variable must be set!`)
-		}
-		deploymentName, err := kube.ParseDeploymentNameFromPodName(operatorPodName)
-		if err != nil {
-			log.Fatalf(`Failed to get Operator Deployment name from "OPERATOR_POD_NAME" environment variable: %s`, err.Error())
-		}
-		secretName = deploymentName + "-api-key"
+		secretName = operatorDeploymentName() + "-api-key"
 	}
 	operatorNamespace := os.Getenv("OPERATOR_NAMESPACE")
 	if operatorNamespace == "" {
@@ -291,16 +428,68 @@ func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKe
 	return client.ObjectKey{Namespace: operatorNamespace, Name: secretName}
 }
 
-func initCustomZapLogger(level, encoding string) (*zap.Logger, error) {
-	lv := zap.AtomicLevel{}
+func operatorDeploymentName() string {
+	operatorPodName := os.Getenv("OPERATOR_POD_NAME")
+	if operatorPodName == "" {
+		log.Fatal(`"OPERATOR_POD_NAME" environment variable must be set!`)
+	}
+	deploymentName, err := kube.ParseDeploymentNameFromPodName(operatorPodName)
+	if err != nil {
+		log.Fatalf(`Failed to get Operator Deployment name from "OPERATOR_POD_NAME" environment variable: %s`, err.Error())
+	}
+	return deploymentName
+}
+
+// operatorSettings returns the settings passed to the Operator with the flags and the environment variables
+func (c *Config) operatorSettings(logLevel zapcore.Level) atlasoperatorconfig.Settings {
+	namespaces := make([]string, 0, len(c.WatchedNamespaces))
+	for namespace := range c.WatchedNamespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	return atlasoperatorconfig.Settings{
+		LogLevel:                    logLevel,
+		ObjectDeletionProtection:    c.ObjectDeletionProtection,
+		SubObjectDeletionProtection: c.SubObjectDeletionProtection,
+		SyncPeriod:                  c.SyncPeriod,
+		AtlasDomain:                 c.AtlasDomain,
+		Retry:                       workflow.DefaultRetryIntervals(),
+		WatchedNamespaces:           atlasoperatorconfig.NormalizeNamespaces(namespaces),
+	}
+}
+
+// applyOperatorSettings overrides the configuration with the settings read from the AtlasOperatorConfig
+func (c *Config) applyOperatorSettings(settings atlasoperatorconfig.Settings) {
+	c.LogLevel = settings.LogLevel.String()
+	c.ObjectDeletionProtection = settings.ObjectDeletionProtection
+	c.SubObjectDeletionProtection = settings.SubObjectDeletionProtection
+	c.SyncPeriod = settings.SyncPeriod
+	c.AtlasDomain = settings.AtlasDomain
+
+	c.WatchedNamespaces = map[string]bool{}
+	c.Namespace = ""
+	if len(settings.WatchedNamespaces) == 0 {
+		c.WatchedNamespaces[""] = true
+		return
+	}
+	for _, namespace := range settings.WatchedNamespaces {
+		c.WatchedNamespaces[namespace] = true
+	}
+	if len(settings.WatchedNamespaces) == 1 {
+		c.Namespace = settings.WatchedNamespaces[0]
+	}
+}
+
+func initCustomZapLogger(level, encoding string) (*zap.Logger, zap.AtomicLevel, error) {
+	// the level is changed at runtime once the AtlasOperatorConfig is updated
+	lv := zap.NewAtomicLevel()
 	err := lv.UnmarshalText([]byte(strings.ToLower(level)))
 	if err != nil {
-		return nil, err
+		return nil, lv, err
 	}
 
 	enc := strings.ToLower(encoding)
 	if enc != "json" && enc != "console" {
-		return nil, errors.New("'encoding' parameter can only by either 'json' or 'console'")
+		return nil, lv, errors.New("'encoding' parameter can only by either 'json' or 'console'")
 	}
 
 	cfg := zap.Config{
@@ -317,7 +506,8 @@ func initCustomZapLogger(level, encoding string) (*zap.Logger, error) {
 			EncodeTime:  zapcore.ISO8601TimeEncoder,
 		},
 	}
-	return cfg.Build()
+	logger, err := cfg.Build()
+	return logger, lv, err
 }
 
 func configureDeletionProtection(config *Config) {
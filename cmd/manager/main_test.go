@@ -3,8 +3,10 @@ package main
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/version"
 )
@@ -87,3 +89,25 @@ func TestDeletionProtectionEnabledAsEnvVars(t *testing.T) {
 		})
 	}
 }
+
+func TestOperatorSettings(t *testing.T) {
+	cfg := Config{
+		AtlasDomain:       "https://cloud.mongodb.com/",
+		WatchedNamespaces: map[string]bool{"": true},
+		SyncPeriod:        time.Hour,
+	}
+	settings := cfg.operatorSettings(zapcore.WarnLevel)
+	assert.Empty(t, settings.WatchedNamespaces)
+	assert.Equal(t, time.Hour, settings.SyncPeriod)
+
+	settings.WatchedNamespaces = []string{"ns"}
+	cfg.applyOperatorSettings(settings)
+	assert.Equal(t, map[string]bool{"ns": true}, cfg.WatchedNamespaces)
+	assert.Equal(t, "ns", cfg.Namespace)
+	assert.Equal(t, "warn", cfg.LogLevel)
+
+	settings.WatchedNamespaces = []string{"ns1", "ns2"}
+	cfg.applyOperatorSettings(settings)
+	assert.Equal(t, map[string]bool{"ns1": true, "ns2": true}, cfg.WatchedNamespaces)
+	assert.Empty(t, cfg.Namespace)
+}
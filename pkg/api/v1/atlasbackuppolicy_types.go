@@ -64,6 +64,10 @@ func (in *AtlasBackupPolicy) UpdateStatus(conditions []status.Condition, options
 	}
 }
 
+func (in *AtlasBackupPolicy) SetRetry(retry *status.Retry) {
+	in.Status.Retry = retry
+}
+
 //+kubebuilder:object:root=true
 
 // AtlasBackupPolicyList contains a list of AtlasBackupPolicy
@@ -159,6 +159,10 @@ func (in *AtlasBackupSchedule) UpdateStatus(conditions []status.Condition, optio
 	}
 }
 
+func (in *AtlasBackupSchedule) SetRetry(retry *status.Retry) {
+	in.Status.Retry = retry
+}
+
 //+kubebuilder:object:root=true
 
 // AtlasBackupScheduleList contains a list of AtlasBackupSchedule
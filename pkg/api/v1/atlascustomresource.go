@@ -23,3 +23,4 @@ var _ AtlasCustomResource = &AtlasDatabaseUser{}
 var _ AtlasCustomResource = &AtlasDataFederation{}
 var _ AtlasCustomResource = &AtlasBackupSchedule{}
 var _ AtlasCustomResource = &AtlasBackupPolicy{}
+var _ AtlasCustomResource = &AtlasOperatorConfig{}
@@ -73,14 +73,107 @@ type AtlasDatabaseUserSpec struct {
 	// Scopes is an array of clusters and Atlas Data Lakes that this user has access to.
 	Scopes []ScopeSpec `json:"scopes,omitempty"`
 
-	// PasswordSecret is a reference to the Secret keeping the user password.
+	// PasswordSecret is a reference to the Secret keeping the user password. The Secret may not exist if the password
+	// is generated.
 	PasswordSecret *common.ResourceRef `json:"passwordSecretRef,omitempty"`
 
+	// GeneratePassword makes the Operator generate the password and create the Secret keeping it if the Secret doesn't
+	// exist. The Secret is named after 'passwordSecretRef' or '<name>-password' and is never overwritten.
+	// +optional
+	GeneratePassword *PasswordPolicy `json:"generatePassword,omitempty"`
+
 	// Username is a username for authenticating to MongoDB.
 	Username string `json:"username"`
 
-	// X509Type is X.509 method by which the database authenticates the provided username
+	// X509Type is X.509 method by which the database authenticates the provided username. The MANAGED users get the
+	// certificate issued by Atlas in their connection Secrets.
 	X509Type string `json:"x509Type,omitempty"`
+
+	// X509MonthsUntilExpiration is the number of months the certificates issued by Atlas for the MANAGED X.509 user
+	// are valid for. The certificates are renewed after two thirds of this period. Default value is 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	// +optional
+	X509MonthsUntilExpiration int `json:"x509MonthsUntilExpiration,omitempty"`
+
+	// ConnectionSecretTemplate renders custom keys of the connection Secrets created for the user
+	// +optional
+	ConnectionSecretTemplate *ConnectionSecretTemplate `json:"connectionSecretTemplate,omitempty"`
+
+	// ServiceBinding adds the keys defined by the Service Binding specification ('type', 'provider', 'host', 'port',
+	// 'database' and 'uri') to the connection Secrets of the user and exposes the Secret in 'status.binding'
+	// +optional
+	ServiceBinding bool `json:"serviceBinding,omitempty"`
+
+	// ConnectionSecretTargets are the namespaces the connection Secrets are replicated to in addition to the namespace
+	// of the AtlasDatabaseUser. The target namespaces must be watched by the Operator and allow the namespace of the
+	// AtlasDatabaseUser with the 'atlas.mongodb.com/connection-secret-sources' annotation (comma-separated namespaces
+	// or '*'). The existing Secrets not replicated from the AtlasDatabaseUser are never overwritten.
+	// +optional
+	ConnectionSecretTargets *ConnectionSecretTargets `json:"connectionSecretTargets,omitempty"`
+}
+
+// ConnectionSecretTemplate describes the keys of the connection Secrets rendered with Go text/template templates.
+// The templates are rendered for each connection Secret with the fields: .Username, .Password, .AuthDatabase,
+// .ProjectID, .ProjectName, .DeploymentName, .Hosts (the 'host:port' list), .SrvHost, .Options (the options of the
+// standard connection string), .ConnectionStringStandard, .ConnectionStringStandardSrv and .PrivateEndpoints (the list
+// of .PvtConnURL, .PvtSrvConnURL and .PvtShardConnURL). The functions 'join', 'pathEscape' and 'queryEscape' are
+// available in addition to the built-in ones.
+type ConnectionSecretTemplate struct {
+	// Data are the templates of the values by the keys of the Secret
+	// +kubebuilder:validation:MinProperties=1
+	Data map[string]string `json:"data"`
+
+	// SkipDefaultKeys excludes the keys written by default ('username', 'password', 'connectionStringStandard', the
+	// Service Binding keys etc.) from the Secret, so that it contains only the keys of the template
+	// +optional
+	SkipDefaultKeys bool `json:"skipDefaultKeys,omitempty"`
+}
+
+// PasswordPolicy describes the passwords generated by the Operator.
+type PasswordPolicy struct {
+	// Length is the total number of characters in the password
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	Length int `json:"length,omitempty"`
+
+	// Digits is the number of digits in the password
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Digits int `json:"digits,omitempty"`
+
+	// Symbols is the number of symbols in the password
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Symbols int `json:"symbols,omitempty"`
+
+	// SymbolCharset is the set of the symbols the password may contain. Default value is the ASCII punctuation
+	// +optional
+	SymbolCharset string `json:"symbolCharset,omitempty"`
+
+	// NoUpper excludes the uppercase letters from the password
+	// +optional
+	NoUpper bool `json:"noUpper,omitempty"`
+
+	// AllowRepeat allows the characters to repeat in the password
+	// +optional
+	AllowRepeat bool `json:"allowRepeat,omitempty"`
+}
+
+// ConnectionSecretTargets selects the namespaces the connection Secrets are replicated to. The replicas are removed
+// from the namespaces that are not selected anymore. The namespaces must be watched by the Operator and opt in with
+// the 'atlas.mongodb.com/connection-secret-sources' annotation. The replicas are marked with the
+// 'atlas.mongodb.com/connection-secret-source' annotation referencing the AtlasDatabaseUser.
+type ConnectionSecretTargets struct {
+	// Namespaces is the list of the namespaces to replicate the connection Secrets to
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects the namespaces to replicate the connection Secrets to by their labels
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -140,11 +233,16 @@ func (p AtlasDatabaseUser) AtlasProjectObjectKey() client.ObjectKey {
 	return kube.ObjectKey(ns, p.Spec.Project.Name)
 }
 
+// PasswordSecretObjectKey returns the key of the Secret keeping the user password, nil if the user doesn't have one.
 func (p AtlasDatabaseUser) PasswordSecretObjectKey() *client.ObjectKey {
-	if p.Spec.PasswordSecret != nil {
+	if p.Spec.PasswordSecret != nil && (p.Spec.PasswordSecret.Name != "" || p.Spec.GeneratePassword == nil) {
 		key := kube.ObjectKey(p.Namespace, p.Spec.PasswordSecret.Name)
 		return &key
 	}
+	if p.Spec.GeneratePassword != nil {
+		key := kube.ObjectKey(p.Namespace, p.Name+"-password")
+		return &key
+	}
 	return nil
 }
 
@@ -163,10 +261,14 @@ func (p *AtlasDatabaseUser) UpdateStatus(conditions []status.Condition, options
 	}
 }
 
-func (p *AtlasDatabaseUser) ReadPassword(kubeClient client.Client) (string, error) {
-	if p.Spec.PasswordSecret != nil {
+func (p *AtlasDatabaseUser) SetRetry(retry *status.Retry) {
+	p.Status.Retry = retry
+}
+
+func (p *AtlasDatabaseUser) ReadPassword(kubeClient client.Reader) (string, error) {
+	if key := p.PasswordSecretObjectKey(); key != nil {
 		secret := &corev1.Secret{}
-		if err := kubeClient.Get(context.Background(), *p.PasswordSecretObjectKey(), secret); err != nil {
+		if err := kubeClient.Get(context.Background(), *key, secret); err != nil {
 			return "", err
 		}
 		p, exist := secret.Data["password"]
@@ -183,17 +285,23 @@ func (p *AtlasDatabaseUser) ReadPassword(kubeClient client.Client) (string, erro
 }
 
 // ToAtlas converts the AtlasDatabaseUser to native Atlas client format. Reads the password from the Secret
-func (p AtlasDatabaseUser) ToAtlas(kubeClient client.Client) (*mongodbatlas.DatabaseUser, error) {
+func (p AtlasDatabaseUser) ToAtlas(kubeClient client.Reader) (*mongodbatlas.DatabaseUser, error) {
+	result := &mongodbatlas.DatabaseUser{}
+	if err := compat.JSONCopy(result, p.Spec); err != nil {
+		return nil, err
+	}
+	// The users authenticating with X.509 certificates don't have a password
+	if p.Spec.X509Type != "" && p.Spec.X509Type != "NONE" {
+		return result, nil
+	}
+
 	password, err := p.ReadPassword(kubeClient)
 	if err != nil {
 		return nil, err
 	}
-
-	result := &mongodbatlas.DatabaseUser{}
-	err = compat.JSONCopy(result, p.Spec)
 	result.Password = password
 
-	return result, err
+	return result, nil
 }
 
 func (p AtlasDatabaseUser) GetScopes(scopeType ScopeType) []string {
@@ -172,6 +172,10 @@ func (c *AtlasDataFederation) UpdateStatus(conditions []status.Condition, option
 	}
 }
 
+func (c *AtlasDataFederation) SetRetry(retry *status.Retry) {
+	c.Status.Retry = retry
+}
+
 func (c *AtlasDataFederation) ToAtlas() (*mongodbatlas.DataFederationInstance, error) {
 	result := &mongodbatlas.DataFederationInstance{}
 	err := compat.JSONCopy(result, c.Spec)
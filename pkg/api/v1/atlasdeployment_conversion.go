@@ -0,0 +1,12 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package v1
+
+// Hub marks v1 as the conversion hub of AtlasDeployment, every other version converts to and from it.
+func (*AtlasDeployment) Hub() {}
@@ -1,13 +1,15 @@
-package atlasdeployment
+package v1
 
 import (
 	"errors"
+	"sort"
 
-	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
 )
 
-func ConvertLegacyDeployment(deploymentSpec *mdbv1.AtlasDeploymentSpec) error {
+// ConvertLegacyDeployment fills the AdvancedDeploymentSpec from the legacy DeploymentSpec.
+// The legacy DeploymentSpec is kept, it is up to the caller to drop it.
+func ConvertLegacyDeployment(deploymentSpec *AtlasDeploymentSpec) error {
 	legacy := deploymentSpec.DeploymentSpec
 
 	if legacy == nil {
@@ -19,7 +21,7 @@ func ConvertLegacyDeployment(deploymentSpec *mdbv1.AtlasDeploymentSpec) error {
 		return err
 	}
 
-	deploymentSpec.AdvancedDeploymentSpec = &mdbv1.AdvancedDeploymentSpec{
+	deploymentSpec.AdvancedDeploymentSpec = &AdvancedDeploymentSpec{
 		BackupEnabled:            legacy.ProviderBackupEnabled,
 		BiConnector:              legacy.BIConnector,
 		ClusterType:              getDefaultClusterType(legacy.ClusterType),
@@ -39,8 +41,8 @@ func ConvertLegacyDeployment(deploymentSpec *mdbv1.AtlasDeploymentSpec) error {
 	return nil
 }
 
-func convertLegacyReplicationSpecs(legacy *mdbv1.DeploymentSpec) ([]*mdbv1.AdvancedReplicationSpec, error) {
-	result := []*mdbv1.AdvancedReplicationSpec{}
+func convertLegacyReplicationSpecs(legacy *DeploymentSpec) ([]*AdvancedReplicationSpec, error) {
+	result := []*AdvancedReplicationSpec{}
 
 	if legacy == nil {
 		return result, nil
@@ -55,27 +57,35 @@ func convertLegacyReplicationSpecs(legacy *mdbv1.DeploymentSpec) ([]*mdbv1.Advan
 	}
 
 	for _, legacyResplicationSpec := range legacy.ReplicationSpecs {
-		resplicationSpec := &mdbv1.AdvancedReplicationSpec{
+		resplicationSpec := &AdvancedReplicationSpec{
 			NumShards:     *convertLegacyInt64(legacyResplicationSpec.NumShards),
 			ZoneName:      legacyResplicationSpec.ZoneName,
-			RegionConfigs: []*mdbv1.AdvancedRegionConfig{},
+			RegionConfigs: []*AdvancedRegionConfig{},
 		}
 
-		for legacyRegion, legacyRegionConfig := range legacyResplicationSpec.RegionsConfig {
-			regionConfig := mdbv1.AdvancedRegionConfig{
-				AnalyticsSpecs: &mdbv1.Specs{
+		// The regions are sorted for the conversion to be stable
+		regions := make([]string, 0, len(legacyResplicationSpec.RegionsConfig))
+		for legacyRegion := range legacyResplicationSpec.RegionsConfig {
+			regions = append(regions, legacyRegion)
+		}
+		sort.Strings(regions)
+
+		for _, legacyRegion := range regions {
+			legacyRegionConfig := legacyResplicationSpec.RegionsConfig[legacyRegion]
+			regionConfig := AdvancedRegionConfig{
+				AnalyticsSpecs: &Specs{
 					DiskIOPS:      legacy.ProviderSettings.DiskIOPS,
 					EbsVolumeType: legacy.ProviderSettings.VolumeType,
 					InstanceSize:  legacy.ProviderSettings.InstanceSizeName,
 					NodeCount:     convertLegacyInt64(legacyRegionConfig.AnalyticsNodes),
 				},
-				ElectableSpecs: &mdbv1.Specs{
+				ElectableSpecs: &Specs{
 					DiskIOPS:      legacy.ProviderSettings.DiskIOPS,
 					EbsVolumeType: legacy.ProviderSettings.VolumeType,
 					InstanceSize:  legacy.ProviderSettings.InstanceSizeName,
 					NodeCount:     convertLegacyInt64(legacyRegionConfig.ElectableNodes),
 				},
-				ReadOnlySpecs: &mdbv1.Specs{
+				ReadOnlySpecs: &Specs{
 					DiskIOPS:      legacy.ProviderSettings.DiskIOPS,
 					EbsVolumeType: legacy.ProviderSettings.VolumeType,
 					InstanceSize:  legacy.ProviderSettings.InstanceSizeName,
@@ -97,19 +107,19 @@ func convertLegacyReplicationSpecs(legacy *mdbv1.DeploymentSpec) ([]*mdbv1.Advan
 	return result, nil
 }
 
-func convertLegacyAutoScaling(legacyRoot, legacyPS *mdbv1.AutoScalingSpec) *mdbv1.AdvancedAutoScalingSpec {
+func convertLegacyAutoScaling(legacyRoot, legacyPS *AutoScalingSpec) *AdvancedAutoScalingSpec {
 	if legacyRoot == nil || legacyPS == nil {
 		return nil
 	}
 
-	autoScaling := &mdbv1.AdvancedAutoScalingSpec{
-		DiskGB: &mdbv1.DiskGB{
+	autoScaling := &AdvancedAutoScalingSpec{
+		DiskGB: &DiskGB{
 			Enabled: legacyRoot.DiskGBEnabled,
 		},
 	}
 
 	if legacyRoot.Compute != nil && legacyRoot.Compute.Enabled != nil {
-		autoScaling.Compute = &mdbv1.ComputeSpec{
+		autoScaling.Compute = &ComputeSpec{
 			Enabled:          legacyRoot.Compute.Enabled,
 			ScaleDownEnabled: legacyRoot.Compute.ScaleDownEnabled,
 			MinInstanceSize:  emptyIfDisabled(legacyPS.Compute.MinInstanceSize, legacyRoot.Compute.Enabled),
@@ -120,10 +130,10 @@ func convertLegacyAutoScaling(legacyRoot, legacyPS *mdbv1.AutoScalingSpec) *mdbv
 	return autoScaling
 }
 
-func fillDefaultReplicationSpec(legacy *mdbv1.DeploymentSpec) {
-	replicationSpec := mdbv1.ReplicationSpec{
+func fillDefaultReplicationSpec(legacy *DeploymentSpec) {
+	replicationSpec := ReplicationSpec{
 		NumShards: toptr.MakePtr[int64](1),
-		RegionsConfig: map[string]mdbv1.RegionsConfig{
+		RegionsConfig: map[string]RegionsConfig{
 			legacy.ProviderSettings.RegionName: {
 				AnalyticsNodes: toptr.MakePtr(int64(0)),
 				ElectableNodes: toptr.MakePtr(int64(3)),
@@ -133,15 +143,15 @@ func fillDefaultReplicationSpec(legacy *mdbv1.DeploymentSpec) {
 		},
 	}
 
-	if legacy.ClusterType == mdbv1.TypeGeoSharded {
+	if legacy.ClusterType == TypeGeoSharded {
 		replicationSpec.ZoneName = "Zone 1"
 	}
 
 	legacy.ReplicationSpecs = append(legacy.ReplicationSpecs, replicationSpec)
 }
 
-func getDefaultClusterType(legacyType mdbv1.DeploymentType) string {
-	clusterType := mdbv1.TypeReplicaSet
+func getDefaultClusterType(legacyType DeploymentType) string {
+	clusterType := TypeReplicaSet
 
 	if legacyType != "" {
 		clusterType = legacyType
@@ -1,11 +1,10 @@
-package atlasdeployment
+package v1
 
 import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
@@ -29,18 +28,18 @@ func TestConvertLegacyDeployment(t *testing.T) {
 	})
 }
 
-func CreateBasicDeployment(name string) *v1.AtlasDeployment {
-	return &v1.AtlasDeployment{
+func CreateBasicDeployment(name string) *AtlasDeployment {
+	return &AtlasDeployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
-		Spec: v1.AtlasDeploymentSpec{
+		Spec: AtlasDeploymentSpec{
 			Project: common.ResourceRefNamespaced{
 				Name: "my-project",
 			},
-			DeploymentSpec: &v1.DeploymentSpec{
+			DeploymentSpec: &DeploymentSpec{
 				Name: "cluster-basics",
-				ProviderSettings: &v1.ProviderSettingsSpec{
+				ProviderSettings: &ProviderSettingsSpec{
 					InstanceSizeName:    "M2",
 					ProviderName:        "TENANT",
 					RegionName:          "US_EAST_1",
@@ -51,7 +50,7 @@ func CreateBasicDeployment(name string) *v1.AtlasDeployment {
 	}
 }
 
-func CreateDeploymentWithMultiregion(name string, providerName provider.ProviderName) *v1.AtlasDeployment {
+func CreateDeploymentWithMultiregion(name string, providerName provider.ProviderName) *AtlasDeployment {
 	var regions []string
 	switch providerName {
 	case provider.ProviderAWS:
@@ -66,27 +65,27 @@ func CreateDeploymentWithMultiregion(name string, providerName provider.Provider
 		panic("unknown provider")
 	}
 
-	return &v1.AtlasDeployment{
+	return &AtlasDeployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
-		Spec: v1.AtlasDeploymentSpec{
+		Spec: AtlasDeploymentSpec{
 			Project: common.ResourceRefNamespaced{
 				Name: "my-project",
 			},
-			DeploymentSpec: &v1.DeploymentSpec{
+			DeploymentSpec: &DeploymentSpec{
 				Name:                  "deployment-multiregion",
 				ProviderBackupEnabled: toptr.MakePtr(true),
 				ClusterType:           "REPLICASET",
-				ProviderSettings: &v1.ProviderSettingsSpec{
+				ProviderSettings: &ProviderSettingsSpec{
 					InstanceSizeName: "M10",
 					ProviderName:     providerName,
 				},
-				ReplicationSpecs: []v1.ReplicationSpec{
+				ReplicationSpecs: []ReplicationSpec{
 					{
 						NumShards: toptr.MakePtr(int64(1)),
 						ZoneName:  "US-Zone",
-						RegionsConfig: map[string]v1.RegionsConfig{
+						RegionsConfig: map[string]RegionsConfig{
 							regions[0]: {
 								AnalyticsNodes: toptr.MakePtr(int64(0)),
 								ElectableNodes: toptr.MakePtr(int64(1)),
@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"go.mongodb.org/atlas/mongodbatlas"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -578,6 +579,7 @@ func (spec *AtlasDeploymentSpec) Deployment() (*mongodbatlas.AdvancedCluster, er
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 
 // AtlasDeployment is the Schema for the atlasdeployments API
 type AtlasDeployment struct {
@@ -617,6 +619,79 @@ func (c *AtlasDeployment) IsAdvancedDeployment() bool {
 	return c.Spec.AdvancedDeploymentSpec != nil
 }
 
+// Normalize brings the spec to the canonical form sent to Atlas: instance sizes are upper-cased and
+// shared tier (M0/M2/M5) and serverless deployments use the TENANT and SERVERLESS providers, keeping the
+// original cloud provider as the backing one.
+func (c *AtlasDeployment) Normalize() {
+	if c.Spec.DeploymentSpec != nil && c.Spec.DeploymentSpec.ProviderSettings != nil {
+		pSettings := c.Spec.DeploymentSpec.ProviderSettings
+		pSettings.InstanceSizeName = normalizeInstanceSize(pSettings.InstanceSizeName)
+		if pSettings.AutoScaling != nil {
+			normalizeComputeSpec(pSettings.AutoScaling.Compute)
+		}
+		if isSharedInstanceSize(pSettings.InstanceSizeName) && pSettings.ProviderName != provider.ProviderTenant {
+			pSettings.BackingProviderName = string(pSettings.ProviderName)
+			pSettings.ProviderName = provider.ProviderTenant
+		}
+	}
+
+	if c.Spec.ServerlessSpec != nil && c.Spec.ServerlessSpec.ProviderSettings != nil {
+		pSettings := c.Spec.ServerlessSpec.ProviderSettings
+		if pSettings.ProviderName != provider.ProviderServerless {
+			pSettings.BackingProviderName = string(pSettings.ProviderName)
+			pSettings.ProviderName = provider.ProviderServerless
+		}
+	}
+
+	if c.Spec.AdvancedDeploymentSpec != nil {
+		for _, replicationSpec := range c.Spec.AdvancedDeploymentSpec.ReplicationSpecs {
+			if replicationSpec == nil {
+				continue
+			}
+			for _, regionConfig := range replicationSpec.RegionConfigs {
+				if regionConfig != nil {
+					regionConfig.normalize()
+				}
+			}
+		}
+	}
+}
+
+func (rc *AdvancedRegionConfig) normalize() {
+	for _, specs := range []*Specs{rc.ElectableSpecs, rc.ReadOnlySpecs, rc.AnalyticsSpecs} {
+		if specs != nil {
+			specs.InstanceSize = normalizeInstanceSize(specs.InstanceSize)
+		}
+	}
+	if rc.AutoScaling != nil {
+		normalizeComputeSpec(rc.AutoScaling.Compute)
+	}
+	if rc.ElectableSpecs != nil && isSharedInstanceSize(rc.ElectableSpecs.InstanceSize) && rc.ProviderName != string(provider.ProviderTenant) {
+		rc.BackingProviderName = rc.ProviderName
+		rc.ProviderName = string(provider.ProviderTenant)
+	}
+}
+
+func normalizeComputeSpec(compute *ComputeSpec) {
+	if compute == nil {
+		return
+	}
+	compute.MinInstanceSize = normalizeInstanceSize(compute.MinInstanceSize)
+	compute.MaxInstanceSize = normalizeInstanceSize(compute.MaxInstanceSize)
+}
+
+func normalizeInstanceSize(instanceSize string) string {
+	return strings.ToUpper(strings.TrimSpace(instanceSize))
+}
+
+func isSharedInstanceSize(instanceSize string) bool {
+	switch instanceSize {
+	case "M0", "M2", "M5":
+		return true
+	}
+	return false
+}
+
 // +kubebuilder:object:root=true
 
 // AtlasDeploymentList contains a list of AtlasDeployment
@@ -649,6 +724,10 @@ func (c *AtlasDeployment) UpdateStatus(conditions []status.Condition, options ..
 	}
 }
 
+func (c *AtlasDeployment) SetRetry(retry *status.Retry) {
+	c.Status.Retry = retry
+}
+
 // ************************************ Builder methods *************************************************
 
 func NewDeployment(namespace, name, nameInAtlas string) *AtlasDeployment {
@@ -214,3 +214,47 @@ func TestToAtlas(t *testing.T) {
 	areTheyEqual := operatorArgs.IsEqual(atlasArgs)
 	assert.True(t, areTheyEqual, "should be equal after conversion")
 }
+
+func TestNormalize(t *testing.T) {
+	t.Run("shared tier legacy deployment uses the TENANT provider", func(t *testing.T) {
+		deployment := DefaultAWSDeployment("default", "project").WithInstanceSize("m2")
+		deployment.Normalize()
+
+		assert.Equal(t, "M2", deployment.Spec.DeploymentSpec.ProviderSettings.InstanceSizeName)
+		assert.Equal(t, provider.ProviderTenant, deployment.Spec.DeploymentSpec.ProviderSettings.ProviderName)
+		assert.Equal(t, string(provider.ProviderAWS), deployment.Spec.DeploymentSpec.ProviderSettings.BackingProviderName)
+	})
+
+	t.Run("dedicated legacy deployment keeps its provider", func(t *testing.T) {
+		deployment := DefaultAWSDeployment("default", "project").WithInstanceSize("m10")
+		deployment.Normalize()
+
+		assert.Equal(t, "M10", deployment.Spec.DeploymentSpec.ProviderSettings.InstanceSizeName)
+		assert.Equal(t, provider.ProviderAWS, deployment.Spec.DeploymentSpec.ProviderSettings.ProviderName)
+		assert.Empty(t, deployment.Spec.DeploymentSpec.ProviderSettings.BackingProviderName)
+	})
+
+	t.Run("serverless instance uses the SERVERLESS provider", func(t *testing.T) {
+		deployment := NewDefaultAWSServerlessInstance("default", "project")
+		deployment.Spec.ServerlessSpec.ProviderSettings.ProviderName = provider.ProviderAWS
+		deployment.Spec.ServerlessSpec.ProviderSettings.BackingProviderName = ""
+		deployment.Normalize()
+
+		assert.Equal(t, provider.ProviderServerless, deployment.Spec.ServerlessSpec.ProviderSettings.ProviderName)
+		assert.Equal(t, string(provider.ProviderAWS), deployment.Spec.ServerlessSpec.ProviderSettings.BackingProviderName)
+	})
+
+	t.Run("advanced deployment instance sizes are upper-cased", func(t *testing.T) {
+		deployment := DefaultAwsAdvancedDeployment("default", "project")
+		regionConfig := deployment.Spec.AdvancedDeploymentSpec.ReplicationSpecs[0].RegionConfigs[0]
+		regionConfig.ElectableSpecs.InstanceSize = "m5"
+		regionConfig.AutoScaling = &AdvancedAutoScalingSpec{Compute: &ComputeSpec{MinInstanceSize: "m5", MaxInstanceSize: " m10 "}}
+		deployment.Normalize()
+
+		assert.Equal(t, "M5", regionConfig.ElectableSpecs.InstanceSize)
+		assert.Equal(t, "M5", regionConfig.AutoScaling.Compute.MinInstanceSize)
+		assert.Equal(t, "M10", regionConfig.AutoScaling.Compute.MaxInstanceSize)
+		assert.Equal(t, string(provider.ProviderTenant), regionConfig.ProviderName)
+		assert.Equal(t, string(provider.ProviderAWS), regionConfig.BackingProviderName)
+	})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+// AtlasOperatorConfigSpec defines the settings of the operator. The settings not specified keep the values
+// passed to the operator with the command line flags and the environment variables.
+// The log level, the deletion protection, the Atlas domain and the retry intervals are applied at runtime,
+// the sync period and the watched namespaces are applied once the operator restarts.
+type AtlasOperatorConfigSpec struct {
+	// LogLevel is the level of the operator logs. Applied at runtime.
+	// +kubebuilder:validation:Enum=debug;info;warn;error;dpanic;panic;fatal
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// ObjectDeletionProtection defines if the Atlas resources are kept when the Kubernetes resources are deleted,
+	// unless they are annotated otherwise. Applied at runtime.
+	// +optional
+	ObjectDeletionProtection *bool `json:"objectDeletionProtection,omitempty"`
+
+	// SubObjectDeletionProtection defines if the Atlas sub-resources not managed by the operator are kept,
+	// e.g. the IP access list entries added outside the operator. Applied at runtime.
+	// +optional
+	SubObjectDeletionProtection *bool `json:"subObjectDeletionProtection,omitempty"`
+
+	// SyncPeriod is the minimum interval at which all the watched resources are reconciled.
+	// Applied once the operator restarts.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+
+	// AtlasDomain is the Atlas URL domain name (with slash in the end). Applied at runtime.
+	// +optional
+	AtlasDomain string `json:"atlasDomain,omitempty"`
+
+	// Retry defines the intervals between the retries of the failed reconciliations. Applied at runtime.
+	// +optional
+	Retry *RetryIntervals `json:"retry,omitempty"`
+
+	// WatchedNamespaces is the list of the namespaces the operator watches, all the namespaces if empty.
+	// Applied once the operator restarts.
+	// +optional
+	WatchedNamespaces []string `json:"watchedNamespaces,omitempty"`
+}
+
+// RetryIntervals defines the intervals between the retries of the failed reconciliations. The interval doubles on
+// each attempt, from the initial one up to the maximum.
+type RetryIntervals struct {
+	// Transient is the interval before the first retry of a transient failure, e.g. an Atlas API outage
+	// +optional
+	Transient *metav1.Duration `json:"transient,omitempty"`
+
+	// MaxTransient is the maximum interval between the retries of a transient failure
+	// +optional
+	MaxTransient *metav1.Duration `json:"maxTransient,omitempty"`
+
+	// Permanent is the interval before the first retry of a failure which needs a change to be resolved,
+	// e.g. an invalid spec
+	// +optional
+	Permanent *metav1.Duration `json:"permanent,omitempty"`
+
+	// MaxPermanent is the maximum interval between the retries of a permanent failure
+	// +optional
+	MaxPermanent *metav1.Duration `json:"maxPermanent,omitempty"`
+}
+
+// AtlasOperatorConfig is the Schema for the runtime settings of the operator.
+// The operator uses the configuration named after its Deployment unless configured otherwise.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Log Level",type=string,JSONPath=`.status.effective.logLevel`
+type AtlasOperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasOperatorConfigSpec          `json:"spec,omitempty"`
+	Status status.AtlasOperatorConfigStatus `json:"status,omitempty"`
+}
+
+func (in *AtlasOperatorConfig) GetStatus() status.Status {
+	return in.Status
+}
+
+func (in *AtlasOperatorConfig) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	in.Status.Conditions = conditions
+	in.Status.ObservedGeneration = in.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasOperatorConfigStatusOption)
+		v(&in.Status)
+	}
+}
+
+func (in *AtlasOperatorConfig) SetRetry(retry *status.Retry) {
+	in.Status.Retry = retry
+}
+
+// +kubebuilder:object:root=true
+
+// AtlasOperatorConfigList contains a list of AtlasOperatorConfig
+type AtlasOperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasOperatorConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AtlasOperatorConfig{}, &AtlasOperatorConfigList{})
+}
@@ -51,6 +51,12 @@ type AtlasProjectSpec struct {
 	// +optional
 	ConnectionSecret *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
 
+	// TransportRef is the Secret or the ConfigMap in the namespace of the project with the proxy, the CA bundle and the
+	// client certificate used to connect to Atlas. The Secret must be labelled with 'atlas.mongodb.com/type=credentials'
+	// to be visible to the Operator. The default Operator transport configuration will be used if not provided.
+	// +optional
+	TransportRef *common.TransportRef `json:"transportRef,omitempty"`
+
 	// ProjectIPAccessList allows to enable the IP Access List for the Project. See more information at
 	// https://docs.atlas.mongodb.com/reference/api/ip-access-list/add-entries-to-access-list/
 	// +optional
@@ -203,6 +209,10 @@ func (p *AtlasProject) UpdateStatus(conditions []status.Condition, options ...st
 	}
 }
 
+func (p *AtlasProject) SetRetry(retry *status.Retry) {
+	p.Status.Retry = retry
+}
+
 func (p *AtlasProject) X509SecretObjectKey() *client.ObjectKey {
 	return p.Spec.X509CertRef.GetObject(p.Namespace)
 }
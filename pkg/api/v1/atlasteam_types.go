@@ -81,6 +81,10 @@ func (in *AtlasTeam) UpdateStatus(conditions []status.Condition, options ...stat
 	}
 }
 
+func (in *AtlasTeam) SetRetry(retry *status.Retry) {
+	in.Status.Retry = retry
+}
+
 func (in *AtlasTeam) ToAtlas() (*mongodbatlas.Team, error) {
 	result := &mongodbatlas.Team{}
 	err := compat.JSONCopy(result, in.Spec)
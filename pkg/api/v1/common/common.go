@@ -34,6 +34,20 @@ type ResourceRefNamespaced struct {
 	Namespace string `json:"namespace"`
 }
 
+// TransportRef is a reference to the Secret or the ConfigMap with the configuration of the connection to Atlas. The keys
+// read are 'ca.crt' (the PEM encoded CA bundle trusted in addition to the system one), 'proxyUrl' (the HTTP(S) proxy
+// the requests are sent through) and 'tls.crt' / 'tls.key' (the client certificate, only read from a Secret).
+type TransportRef struct {
+	// Kind is the kind of the Kubernetes Resource: Secret or ConfigMap
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +kubebuilder:default:=Secret
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the Kubernetes Resource
+	Name string `json:"name"`
+}
+
 // LabelSpec contains key-value pairs that tag and categorize the Cluster/DBUser
 type LabelSpec struct {
 	// +kubebuilder:validation:MaxLength:=255
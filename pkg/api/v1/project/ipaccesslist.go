@@ -1,6 +1,7 @@
 package project
 
 import (
+	"net"
 	"strings"
 
 	"go.mongodb.org/atlas/mongodbatlas"
@@ -43,6 +44,23 @@ func (i IPAccessList) Identifier() interface{} {
 	return i.CIDRBlock + i.AwsSecurityGroup + i.IPAddress
 }
 
+// Normalize returns the entry in its canonical form: a "/32" CIDR block is collapsed to the single IP address
+// it covers, which is what Atlas reports for it anyway.
+func (i IPAccessList) Normalize() IPAccessList {
+	if !strings.HasSuffix(i.CIDRBlock, "/32") || i.IPAddress != "" || i.AwsSecurityGroup != "" {
+		return i
+	}
+
+	ip, _, err := net.ParseCIDR(i.CIDRBlock)
+	if err != nil || ip.To4() == nil {
+		return i
+	}
+
+	i.IPAddress = ip.String()
+	i.CIDRBlock = ""
+	return i
+}
+
 // ************************************ Builder methods *************************************************
 // Note, that we don't use pointers here as the AtlasProject uses this without pointers
 
@@ -17,6 +17,21 @@ func AtlasDatabaseUserNameOption(name string) AtlasDatabaseUserStatusOption {
 	}
 }
 
+// AtlasDatabaseUserBindingOption exposes the connection Secret as the Provisioned Service binding, removes the binding
+// if the name is empty
+func AtlasDatabaseUserBindingOption(secretName string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.Binding = newServiceBinding(secretName)
+	}
+}
+
+// AtlasDatabaseUserConnectionSecretNamespacesOption records the namespaces the connection Secrets are replicated to
+func AtlasDatabaseUserConnectionSecretNamespacesOption(namespaces []string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.ConnectionSecretNamespaces = namespaces
+	}
+}
+
 // AtlasDatabaseUserStatus defines the observed state of AtlasProject
 type AtlasDatabaseUserStatus struct {
 	Common `json:",inline"`
@@ -26,4 +41,14 @@ type AtlasDatabaseUserStatus struct {
 
 	// UserName is the current name of database user.
 	UserName string `json:"name,omitempty"`
+
+	// Binding is the connection Secret of the user following the Service Binding specification. Set if the user enables
+	// the Service Binding and has exactly one connection Secret.
+	// +optional
+	Binding *ServiceBinding `json:"binding,omitempty"`
+
+	// ConnectionSecretNamespaces are the namespaces (other than the one of the AtlasDatabaseUser) the connection
+	// Secrets are replicated to
+	// +optional
+	ConnectionSecretNamespaces []string `json:"connectionSecretNamespaces,omitempty"`
 }
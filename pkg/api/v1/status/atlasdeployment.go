@@ -31,6 +31,11 @@ type AtlasDeploymentStatus struct {
 	// MongoURIUpdated is a timestamp in ISO 8601 date and time format in UTC when the connection string was last updated.
 	// The connection string changes if you update any of the other values.
 	MongoURIUpdated string `json:"mongoURIUpdated,omitempty"`
+
+	// Binding is the connection Secret of the deployment following the Service Binding specification. Set if exactly
+	// one database user with the Service Binding enabled has a connection Secret for the deployment.
+	// +optional
+	Binding *ServiceBinding `json:"binding,omitempty"`
 }
 
 const (
@@ -162,6 +167,14 @@ func AtlasDeploymentConnectionStringsOption(connectionStrings *mongodbatlas.Conn
 	}
 }
 
+// AtlasDeploymentBindingOption exposes the connection Secret as the Provisioned Service binding, removes the binding
+// if the name is empty
+func AtlasDeploymentBindingOption(secretName string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Binding = newServiceBinding(secretName)
+	}
+}
+
 func AtlasDeploymentMongoURIUpdatedOption(mongoURIUpdated string) AtlasDeploymentStatusOption {
 	return func(s *AtlasDeploymentStatus) {
 		s.MongoURIUpdated = mongoURIUpdated
@@ -2,6 +2,7 @@ package status
 
 import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/authmode"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
 )
 
@@ -82,6 +83,56 @@ func AtlasProjectPrometheusOption(prometheus *Prometheus) AtlasProjectStatusOpti
 	}
 }
 
+func AtlasProjectCredentialsOption(credentials *ProjectCredentials) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.Credentials = credentials
+	}
+}
+
+// ProjectCredentials describes the Atlas API credentials the project is managed with. Each of the fields is resolved
+// in the order: the Secret referenced by spec.connectionSecretRef, the Secret labelled with
+// 'atlas.mongodb.com/default-credentials=true' in the namespace of the project, the global Secret of the Operator (or
+// the credentials directory of the Operator if configured).
+type ProjectCredentials struct {
+	// AuthMode is the way the requests to Atlas are authenticated: "APIKey" for the programmatic API keys or
+	// "ServiceAccount" for the service account client credentials
+	// +optional
+	AuthMode string `json:"authMode,omitempty"`
+
+	// OrgID is where the organization ID was read from
+	OrgID CredentialsSource `json:"orgId"`
+
+	// PublicAPIKey is where the public API key was read from
+	// +optional
+	PublicAPIKey *CredentialsSource `json:"publicApiKey,omitempty"`
+
+	// PrivateAPIKey is where the private API key was read from
+	// +optional
+	PrivateAPIKey *CredentialsSource `json:"privateApiKey,omitempty"`
+
+	// ClientID is where the client ID of the service account was read from
+	// +optional
+	ClientID *CredentialsSource `json:"clientId,omitempty"`
+
+	// ClientSecret is where the client secret of the service account was read from
+	// +optional
+	ClientSecret *CredentialsSource `json:"clientSecret,omitempty"`
+}
+
+// CredentialsSource is the Secret or the directory a field of the Atlas API credentials was read from
+type CredentialsSource struct {
+	// Source is the level the field was resolved at: "AtlasProject", "Namespace", "Operator" or "File"
+	Source string `json:"source"`
+
+	// SecretRef is the Secret the field was read from
+	// +optional
+	SecretRef *common.ResourceRefNamespaced `json:"secretRef,omitempty"`
+
+	// Path is the directory of the Operator the field was read from
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // AtlasProjectStatus defines the observed state of AtlasProject
 type AtlasProjectStatus struct {
 	Common `json:",inline"`
@@ -121,4 +172,8 @@ type AtlasProjectStatus struct {
 	// including the prometheusDiscoveryURL
 	// +optional
 	Prometheus *Prometheus `json:"prometheus,omitempty"`
+
+	// Credentials are the Atlas API credentials used for the project
+	// +optional
+	Credentials *ProjectCredentials `json:"credentials,omitempty"`
 }
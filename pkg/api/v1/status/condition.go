@@ -48,6 +48,8 @@ const (
 	ProjectSettingsReadyType        ConditionType = "ProjectSettingsReady"
 	ProjectCustomRolesReadyType     ConditionType = "ProjectCustomRolesReady"
 	ProjectTeamsReadyType           ConditionType = "ProjectTeamsReady"
+	CredentialsResolvedType         ConditionType = "CredentialsResolved"
+	CredentialsValidType            ConditionType = "CredentialsValid"
 )
 
 // AtlasDeployment condition types
@@ -71,7 +73,9 @@ const (
 
 // Generic condition type
 const (
-	ResourceVersionStatus ConditionType = "ResourceVersionIsValid"
+	ResourceVersionStatus     ConditionType = "ResourceVersionIsValid"
+	ReconciliationPlannedType ConditionType = "ReconciliationPlanned"
+	DriftedType               ConditionType = "Drifted"
 )
 
 // Condition describes the state of an Atlas Custom Resource at a certain point.
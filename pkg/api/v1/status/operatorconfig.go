@@ -0,0 +1,41 @@
+package status
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen=false
+
+// AtlasOperatorConfigStatusOption is the option that is applied to AtlasOperatorConfig Status
+type AtlasOperatorConfigStatusOption func(s *AtlasOperatorConfigStatus)
+
+func AtlasOperatorConfigSetEffective(effective OperatorConfiguration) AtlasOperatorConfigStatusOption {
+	return func(s *AtlasOperatorConfigStatus) {
+		s.Effective = &effective
+	}
+}
+
+type AtlasOperatorConfigStatus struct {
+	Common `json:",inline"`
+
+	// Effective is the configuration the operator runs with
+	Effective *OperatorConfiguration `json:"effective,omitempty"`
+}
+
+// OperatorConfiguration is the configuration the operator runs with
+type OperatorConfiguration struct {
+	LogLevel                    string          `json:"logLevel"`
+	ObjectDeletionProtection    bool            `json:"objectDeletionProtection"`
+	SubObjectDeletionProtection bool            `json:"subObjectDeletionProtection"`
+	SyncPeriod                  metav1.Duration `json:"syncPeriod"`
+	AtlasDomain                 string          `json:"atlasDomain"`
+	Retry                       RetryIntervals  `json:"retry"`
+	// WatchedNamespaces is empty if the operator watches all the namespaces
+	WatchedNamespaces []string `json:"watchedNamespaces,omitempty"`
+}
+
+// RetryIntervals are the intervals between the retries of the failed reconciliations
+type RetryIntervals struct {
+	Transient    metav1.Duration `json:"transient"`
+	MaxTransient metav1.Duration `json:"maxTransient"`
+	Permanent    metav1.Duration `json:"permanent"`
+	MaxPermanent metav1.Duration `json:"maxPermanent"`
+}
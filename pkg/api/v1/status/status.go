@@ -1,5 +1,7 @@
 package status
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // +k8s:deepcopy-gen=false
 
 type Reader interface {
@@ -21,6 +23,30 @@ type Status interface {
 	GetConditions() []Condition
 
 	GetObservedGeneration() int64
+
+	GetRetry() *Retry
+}
+
+// +k8s:deepcopy-gen=false
+
+// RetryWriter is implemented by the Custom Resources recording the retries of their failed reconciliations
+type RetryWriter interface {
+	SetRetry(retry *Retry)
+}
+
+// ServiceBinding is the reference to the Secret of the Provisioned Service following the Service Binding specification,
+// see https://servicebinding.io/spec/core/1.0.0/#provisioned-service
+type ServiceBinding struct {
+	// Name is the name of the Secret in the namespace of the resource
+	Name string `json:"name"`
+}
+
+// newServiceBinding returns the binding to the Secret or nil if there is none
+func newServiceBinding(secretName string) *ServiceBinding {
+	if secretName == "" {
+		return nil
+	}
+	return &ServiceBinding{Name: secretName}
 }
 
 var _ Status = &Common{}
@@ -33,6 +59,20 @@ type Common struct {
 	// ObservedGeneration indicates the generation of the resource specification that the Atlas Operator is aware of.
 	// The Atlas Operator updates this field to the 'metadata.generation' as soon as it starts reconciliation of the resource.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Retry describes the retries of the reconciliation while it keeps failing. It's removed once the reconciliation
+	// succeeds or the specification changes.
+	// +optional
+	Retry *Retry `json:"retry,omitempty"`
+}
+
+// Retry describes the retries of a failing reconciliation, which are delayed exponentially
+type Retry struct {
+	// Attempts is the number of consecutive failed reconciliations
+	Attempts int `json:"attempts"`
+
+	// NextRetryTime is the time the reconciliation will be retried at
+	NextRetryTime metav1.Time `json:"nextRetryTime"`
 }
 
 func (c Common) GetConditions() []Condition {
@@ -42,3 +82,7 @@ func (c Common) GetConditions() []Condition {
 func (c Common) GetObservedGeneration() int64 {
 	return c.ObservedGeneration
 }
+
+func (c Common) GetRetry() *Retry {
+	return c.Retry
+}
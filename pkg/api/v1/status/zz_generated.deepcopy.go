@@ -15,6 +15,7 @@ package status
 
 import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/authmode"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
 )
 
@@ -69,6 +70,16 @@ func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
 func (in *AtlasDatabaseUserStatus) DeepCopyInto(out *AtlasDatabaseUserStatus) {
 	*out = *in
 	in.Common.DeepCopyInto(&out.Common)
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(ServiceBinding)
+		**out = **in
+	}
+	if in.ConnectionSecretNamespaces != nil {
+		in, out := &in.ConnectionSecretNamespaces, &out.ConnectionSecretNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserStatus.
@@ -112,6 +123,11 @@ func (in *AtlasDeploymentStatus) DeepCopyInto(out *AtlasDeploymentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(ServiceBinding)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentStatus.
@@ -139,6 +155,27 @@ func (in *AtlasNetworkPeer) DeepCopy() *AtlasNetworkPeer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOperatorConfigStatus) DeepCopyInto(out *AtlasOperatorConfigStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(OperatorConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOperatorConfigStatus.
+func (in *AtlasOperatorConfigStatus) DeepCopy() *AtlasOperatorConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOperatorConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasProjectStatus) DeepCopyInto(out *AtlasProjectStatus) {
 	*out = *in
@@ -194,6 +231,11 @@ func (in *AtlasProjectStatus) DeepCopyInto(out *AtlasProjectStatus) {
 		*out = new(Prometheus)
 		**out = **in
 	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(ProjectCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectStatus.
@@ -278,6 +320,11 @@ func (in *Common) DeepCopyInto(out *Common) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(Retry)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Common.
@@ -328,6 +375,26 @@ func (in *ConnectionStrings) DeepCopy() *ConnectionStrings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsSource) DeepCopyInto(out *CredentialsSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSource.
+func (in *CredentialsSource) DeepCopy() *CredentialsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentValue) DeepCopyInto(out *CurrentValue) {
 	*out = *in
@@ -536,6 +603,28 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	out.Retry = in.Retry
+	if in.WatchedNamespaces != nil {
+		in, out := &in.WatchedNamespaces, &out.WatchedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfiguration.
+func (in *OperatorConfiguration) DeepCopy() *OperatorConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -556,6 +645,42 @@ func (in *PrivateEndpoint) DeepCopy() *PrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCredentials) DeepCopyInto(out *ProjectCredentials) {
+	*out = *in
+	in.OrgID.DeepCopyInto(&out.OrgID)
+	if in.PublicAPIKey != nil {
+		in, out := &in.PublicAPIKey, &out.PublicAPIKey
+		*out = new(CredentialsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateAPIKey != nil {
+		in, out := &in.PrivateAPIKey, &out.PrivateAPIKey
+		*out = new(CredentialsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(CredentialsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(CredentialsSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCredentials.
+func (in *ProjectCredentials) DeepCopy() *ProjectCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectPrivateEndpoint) DeepCopyInto(out *ProjectPrivateEndpoint) {
 	*out = *in
@@ -627,6 +752,41 @@ func (in *ReplicaSet) DeepCopy() *ReplicaSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Retry) DeepCopyInto(out *Retry) {
+	*out = *in
+	in.NextRetryTime.DeepCopyInto(&out.NextRetryTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
+func (in *Retry) DeepCopy() *Retry {
+	if in == nil {
+		return nil
+	}
+	out := new(Retry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryIntervals) DeepCopyInto(out *RetryIntervals) {
+	*out = *in
+	out.Transient = in.Transient
+	out.MaxTransient = in.MaxTransient
+	out.Permanent = in.Permanent
+	out.MaxPermanent = in.MaxPermanent
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryIntervals.
+func (in *RetryIntervals) DeepCopy() *RetryIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessPrivateEndpoint) DeepCopyInto(out *ServerlessPrivateEndpoint) {
 	*out = *in
@@ -642,6 +802,21 @@ func (in *ServerlessPrivateEndpoint) DeepCopy() *ServerlessPrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBinding.
+func (in *ServiceBinding) DeepCopy() *ServiceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamProject) DeepCopyInto(out *TeamProject) {
 	*out = *in
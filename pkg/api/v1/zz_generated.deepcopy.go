@@ -14,6 +14,7 @@ a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
@@ -602,6 +603,21 @@ func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
 		*out = new(common.ResourceRef)
 		**out = **in
 	}
+	if in.GeneratePassword != nil {
+		in, out := &in.GeneratePassword, &out.GeneratePassword
+		*out = new(PasswordPolicy)
+		**out = **in
+	}
+	if in.ConnectionSecretTemplate != nil {
+		in, out := &in.ConnectionSecretTemplate, &out.ConnectionSecretTemplate
+		*out = new(ConnectionSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionSecretTargets != nil {
+		in, out := &in.ConnectionSecretTargets, &out.ConnectionSecretTargets
+		*out = new(ConnectionSecretTargets)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserSpec.
@@ -710,6 +726,105 @@ func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOperatorConfig) DeepCopyInto(out *AtlasOperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOperatorConfig.
+func (in *AtlasOperatorConfig) DeepCopy() *AtlasOperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOperatorConfigList) DeepCopyInto(out *AtlasOperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasOperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOperatorConfigList.
+func (in *AtlasOperatorConfigList) DeepCopy() *AtlasOperatorConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOperatorConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOperatorConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOperatorConfigSpec) DeepCopyInto(out *AtlasOperatorConfigSpec) {
+	*out = *in
+	if in.ObjectDeletionProtection != nil {
+		in, out := &in.ObjectDeletionProtection, &out.ObjectDeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubObjectDeletionProtection != nil {
+		in, out := &in.SubObjectDeletionProtection, &out.SubObjectDeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryIntervals)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatchedNamespaces != nil {
+		in, out := &in.WatchedNamespaces, &out.WatchedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOperatorConfigSpec.
+func (in *AtlasOperatorConfigSpec) DeepCopy() *AtlasOperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasProject) DeepCopyInto(out *AtlasProject) {
 	*out = *in
@@ -777,6 +892,11 @@ func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
 		*out = new(common.ResourceRefNamespaced)
 		**out = **in
 	}
+	if in.TransportRef != nil {
+		in, out := &in.TransportRef, &out.TransportRef
+		*out = new(common.TransportRef)
+		**out = **in
+	}
 	if in.ProjectIPAccessList != nil {
 		in, out := &in.ProjectIPAccessList, &out.ProjectIPAccessList
 		*out = make([]project.IPAccessList, len(*in))
@@ -1131,6 +1251,53 @@ func (in *ComputeSpec) DeepCopy() *ComputeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretTargets) DeepCopyInto(out *ConnectionSecretTargets) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretTargets.
+func (in *ConnectionSecretTargets) DeepCopy() *ConnectionSecretTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretTemplate) DeepCopyInto(out *ConnectionSecretTemplate) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretTemplate.
+func (in *ConnectionSecretTemplate) DeepCopy() *ConnectionSecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionStrings) DeepCopyInto(out *ConnectionStrings) {
 	*out = *in
@@ -1676,6 +1843,21 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -1928,6 +2110,41 @@ func (in *Resource) DeepCopy() *Resource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryIntervals) DeepCopyInto(out *RetryIntervals) {
+	*out = *in
+	if in.Transient != nil {
+		in, out := &in.Transient, &out.Transient
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxTransient != nil {
+		in, out := &in.MaxTransient, &out.MaxTransient
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Permanent != nil {
+		in, out := &in.Permanent, &out.Permanent
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxPermanent != nil {
+		in, out := &in.MaxPermanent, &out.MaxPermanent
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryIntervals.
+func (in *RetryIntervals) DeepCopy() *RetryIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Role) DeepCopyInto(out *Role) {
 	*out = *in
@@ -0,0 +1,116 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// LegacyDeploymentSpecAnnotation keeps the legacy deploymentSpec of a v1 AtlasDeployment read as v2, so that the
+// object converts back to the same v1 spec. Removing the annotation makes the converted advancedDeploymentSpec
+// the one stored.
+const LegacyDeploymentSpecAnnotation = "atlas.mongodb.com/legacy-deployment-spec"
+
+var _ conversion.Convertible = &AtlasDeployment{}
+
+// ConvertTo converts this AtlasDeployment to the hub version (v1).
+// The legacy deploymentSpec is restored if the advancedDeploymentSpec converted from it wasn't changed.
+func (c *AtlasDeployment) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*mdbv1.AtlasDeployment)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", dstRaw)
+	}
+
+	dst.ObjectMeta = *c.ObjectMeta.DeepCopy()
+	dst.Spec = mdbv1.AtlasDeploymentSpec{
+		Project:                c.Spec.Project,
+		AdvancedDeploymentSpec: c.Spec.AdvancedDeploymentSpec,
+		BackupScheduleRef:      c.Spec.BackupScheduleRef,
+		ServerlessSpec:         c.Spec.ServerlessSpec,
+		ProcessArgs:            c.Spec.ProcessArgs,
+	}
+	dst.Status = c.Status
+
+	legacy, ok := dst.Annotations[LegacyDeploymentSpecAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(dst.Annotations, LegacyDeploymentSpecAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	legacySpec := &mdbv1.DeploymentSpec{}
+	if err := json.Unmarshal([]byte(legacy), legacySpec); err != nil {
+		// The annotation was tampered with, the v2 spec is the only one left
+		return nil
+	}
+	if equality.Semantic.DeepEqual(convertLegacy(dst.Spec, legacySpec), c.Spec.AdvancedDeploymentSpec) {
+		dst.Spec.DeploymentSpec = legacySpec
+		dst.Spec.AdvancedDeploymentSpec = nil
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1) to this AtlasDeployment.
+// A legacy deploymentSpec is converted to the advancedDeploymentSpec the operator would send to Atlas for it and
+// kept in the LegacyDeploymentSpecAnnotation. The advancedDeploymentSpec is left empty if the legacy one can't be
+// converted (for example, it has no providerSettings), the conversion itself never fails.
+func (c *AtlasDeployment) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*mdbv1.AtlasDeployment)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", srcRaw)
+	}
+
+	c.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	c.Spec = AtlasDeploymentSpec{
+		Project:                src.Spec.Project,
+		AdvancedDeploymentSpec: src.Spec.AdvancedDeploymentSpec.DeepCopy(),
+		BackupScheduleRef:      src.Spec.BackupScheduleRef,
+		ServerlessSpec:         src.Spec.ServerlessSpec.DeepCopy(),
+		ProcessArgs:            src.Spec.ProcessArgs.DeepCopy(),
+	}
+	c.Status = *src.Status.DeepCopy()
+
+	if !src.IsLegacyDeployment() {
+		return nil
+	}
+
+	legacy, err := json.Marshal(src.Spec.DeploymentSpec)
+	if err != nil {
+		return fmt.Errorf("failed to convert legacy deployment %s/%s: %w", src.Namespace, src.Name, err)
+	}
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	c.Annotations[LegacyDeploymentSpecAnnotation] = string(legacy)
+	c.Spec.AdvancedDeploymentSpec = convertLegacy(src.Spec, src.Spec.DeploymentSpec)
+
+	return nil
+}
+
+// convertLegacy returns the advancedDeploymentSpec for the legacy spec or nil if it can't be converted.
+// Neither of the arguments is changed.
+func convertLegacy(spec mdbv1.AtlasDeploymentSpec, legacy *mdbv1.DeploymentSpec) *mdbv1.AdvancedDeploymentSpec {
+	converted := &mdbv1.AtlasDeployment{Spec: *spec.DeepCopy()}
+	converted.Spec.DeploymentSpec = legacy.DeepCopy()
+	converted.Spec.AdvancedDeploymentSpec = nil
+	converted.Normalize()
+	if err := mdbv1.ConvertLegacyDeployment(&converted.Spec); err != nil {
+		return nil
+	}
+	return converted.Spec.AdvancedDeploymentSpec
+}
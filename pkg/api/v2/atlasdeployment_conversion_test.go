@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
+)
+
+func TestConvertFrom(t *testing.T) {
+	t.Run("legacy deployment is converted to an advanced deployment", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project").WithInstanceSize("M2")
+		dst := &AtlasDeployment{}
+
+		assert.NoError(t, dst.ConvertFrom(src))
+		assert.Equal(t, src.Name, dst.Name)
+		assert.Equal(t, src.Spec.Project, dst.Spec.Project)
+		assert.Nil(t, dst.Spec.ServerlessSpec)
+		assert.NotNil(t, dst.Spec.AdvancedDeploymentSpec)
+		assert.Equal(t, src.Spec.DeploymentSpec.Name, dst.Spec.AdvancedDeploymentSpec.Name)
+
+		regionConfig := dst.Spec.AdvancedDeploymentSpec.ReplicationSpecs[0].RegionConfigs[0]
+		assert.Equal(t, "M2", regionConfig.ElectableSpecs.InstanceSize)
+		assert.Equal(t, string(provider.ProviderTenant), regionConfig.ProviderName)
+		assert.Equal(t, string(provider.ProviderAWS), regionConfig.BackingProviderName)
+
+		assert.Equal(t, provider.ProviderAWS, src.Spec.DeploymentSpec.ProviderSettings.ProviderName, "the source must be kept unchanged")
+	})
+
+	t.Run("legacy deployment without provider settings is kept in the annotation only", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project")
+		src.Spec.DeploymentSpec.ProviderSettings = nil
+		dst := &AtlasDeployment{}
+
+		assert.NoError(t, dst.ConvertFrom(src))
+		assert.Nil(t, dst.Spec.AdvancedDeploymentSpec)
+		assert.Contains(t, dst.Annotations, LegacyDeploymentSpecAnnotation)
+	})
+
+	t.Run("serverless instance is kept", func(t *testing.T) {
+		src := mdbv1.NewDefaultAWSServerlessInstance("default", "project")
+		dst := &AtlasDeployment{}
+
+		assert.NoError(t, dst.ConvertFrom(src))
+		assert.Equal(t, src.Spec.ServerlessSpec, dst.Spec.ServerlessSpec)
+		assert.Nil(t, dst.Spec.AdvancedDeploymentSpec)
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Run("advanced deployment", func(t *testing.T) {
+		src := mdbv1.DefaultAwsAdvancedDeployment("default", "project")
+		v2Deployment := &AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertFrom(src))
+
+		dst := &mdbv1.AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+
+	t.Run("legacy deployment", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project").WithInstanceSize("m10")
+		src.Spec.DeploymentSpec.ReplicationSpecs = []mdbv1.ReplicationSpec{{
+			NumShards: toptr.MakePtr[int64](1),
+			RegionsConfig: map[string]mdbv1.RegionsConfig{
+				"US_EAST_1": {ElectableNodes: toptr.MakePtr[int64](2), Priority: toptr.MakePtr[int64](7)},
+				"US_WEST_2": {ElectableNodes: toptr.MakePtr[int64](1), Priority: toptr.MakePtr[int64](6)},
+				"EU_WEST_1": {ElectableNodes: toptr.MakePtr[int64](2), Priority: toptr.MakePtr[int64](5)},
+			},
+		}}
+		v2Deployment := &AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertFrom(src))
+
+		dst := &mdbv1.AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+
+	t.Run("legacy deployment without provider settings", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project")
+		src.Spec.DeploymentSpec.ProviderSettings = nil
+		v2Deployment := &AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertFrom(src))
+
+		dst := &mdbv1.AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+
+	t.Run("changed legacy deployment is stored as advanced", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project")
+		v2Deployment := &AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertFrom(src))
+		v2Deployment.Spec.AdvancedDeploymentSpec.Paused = toptr.MakePtr(true)
+
+		dst := &mdbv1.AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertTo(dst))
+		assert.Nil(t, dst.Spec.DeploymentSpec)
+		assert.Equal(t, v2Deployment.Spec.AdvancedDeploymentSpec, dst.Spec.AdvancedDeploymentSpec)
+		assert.NotContains(t, dst.Annotations, LegacyDeploymentSpecAnnotation)
+	})
+
+	t.Run("legacy deployment without the annotation is migrated", func(t *testing.T) {
+		src := mdbv1.DefaultAWSDeployment("default", "project")
+		v2Deployment := &AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertFrom(src))
+		delete(v2Deployment.Annotations, LegacyDeploymentSpecAnnotation)
+
+		dst := &mdbv1.AtlasDeployment{}
+		assert.NoError(t, v2Deployment.ConvertTo(dst))
+		assert.Nil(t, dst.Spec.DeploymentSpec)
+		assert.Equal(t, v2Deployment.Spec.AdvancedDeploymentSpec, dst.Spec.AdvancedDeploymentSpec)
+	})
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasDeployment{}, &AtlasDeploymentList{})
+}
+
+// AtlasDeploymentSpec defines the desired state of AtlasDeployment
+// Only one of AdvancedDeploymentSpec and ServerlessSpec should be defined
+type AtlasDeploymentSpec struct {
+	// Project is a reference to AtlasProject resource the deployment belongs to
+	Project common.ResourceRefNamespaced `json:"projectRef"`
+
+	// Configuration for the advanced (v1.5) deployment API https://www.mongodb.com/docs/atlas/reference/api/clusters-advanced/
+	// +optional
+	AdvancedDeploymentSpec *mdbv1.AdvancedDeploymentSpec `json:"advancedDeploymentSpec,omitempty"`
+
+	// Backup schedule for the AtlasDeployment
+	// +optional
+	BackupScheduleRef common.ResourceRefNamespaced `json:"backupRef"`
+
+	// Configuration for the serverless deployment API. https://www.mongodb.com/docs/atlas/reference/api/serverless-instances/
+	// +optional
+	ServerlessSpec *mdbv1.ServerlessSpec `json:"serverlessSpec,omitempty"`
+
+	// ProcessArgs allows to modify Advanced Configuration Options
+	// +optional
+	ProcessArgs *mdbv1.ProcessArgs `json:"processArgs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AtlasDeployment is the Schema for the atlasdeployments API.
+// v1 stays the storage version: a v1 deployment with the legacy deploymentSpec is stored unchanged until the
+// atlas.mongodb.com/legacy-deployment-spec annotation is removed from its v2 representation.
+type AtlasDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasDeploymentSpec          `json:"spec,omitempty"`
+	Status status.AtlasDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AtlasDeploymentList contains a list of AtlasDeployment
+type AtlasDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasDeployment `json:"items"`
+}
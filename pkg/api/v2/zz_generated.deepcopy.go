@@ -0,0 +1,111 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeployment) DeepCopyInto(out *AtlasDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeployment.
+func (in *AtlasDeployment) DeepCopy() *AtlasDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentList) DeepCopyInto(out *AtlasDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentList.
+func (in *AtlasDeploymentList) DeepCopy() *AtlasDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
+	*out = *in
+	out.Project = in.Project
+	if in.AdvancedDeploymentSpec != nil {
+		in, out := &in.AdvancedDeploymentSpec, &out.AdvancedDeploymentSpec
+		*out = new(v1.AdvancedDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.BackupScheduleRef = in.BackupScheduleRef
+	if in.ServerlessSpec != nil {
+		in, out := &in.ServerlessSpec, &out.ServerlessSpec
+		*out = new(v1.ServerlessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProcessArgs != nil {
+		in, out := &in.ProcessArgs, &out.ProcessArgs
+		*out = new(v1.ProcessArgs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
+func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
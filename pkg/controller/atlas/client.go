@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"runtime"
+	"strings"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/version"
 
@@ -13,13 +14,33 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
 )
 
-// Client is the central place to create a client for Atlas using specified API keys and a server URL.
+const (
+	DefaultRequestsPerSecond = 10
+	DefaultRequestsBurst     = 20
+)
+
+// Client is the central place to create a client for Atlas using specified API keys (or the service account) and a
+// server URL.
+// The requests are throttled by the rateLimiter, a nil one doesn't throttle them. The opts decorate the authenticated
+// transport, so every attempt of a retried request goes through them.
 // Note, that the default HTTP transport is reused globally by Go so all caching, keep-alive etc will be in action.
-func Client(atlasDomain string, connection Connection, log *zap.SugaredLogger, opts ...httputil.ClientOpt) (mongodbatlas.Client, error) {
-	withDigest := httputil.Digest(connection.PublicKey, connection.PrivateKey)
+func Client(atlasDomain string, connection Connection, rateLimiter *httputil.RateLimiter, log *zap.SugaredLogger, opts ...httputil.ClientOpt) (mongodbatlas.Client, error) {
+	withAuth := httputil.Digest(connection.PublicKey, connection.PrivateKey)
+	if connection.AuthMode() == ServiceAccountAuth {
+		withAuth = httputil.OAuth2ClientCredentials(tokenURL(atlasDomain), connection.ClientID, connection.ClientSecret)
+	}
 	withLogging := httputil.LoggingTransport(log)
-	allOptions := []httputil.ClientOpt{withDigest, withLogging}
-	allOptions = append(allOptions, opts...)
+	withTracing := httputil.Tracing()
+	allOptions := append([]httputil.ClientOpt{withAuth}, opts...)
+	allOptions = append(allOptions, withLogging)
+	if rateLimiter != nil {
+		allOptions = append(allOptions, httputil.RateLimit(rateLimiter, httputil.DefaultRetryConfig()))
+	}
+	allOptions = append(allOptions, withTracing)
+	if !connection.Transport.IsEmpty() {
+		// the transport is replaced before it's wrapped by the other options
+		allOptions = append([]httputil.ClientOpt{httputil.Transport(connection.Transport)}, allOptions...)
+	}
 
 	httpClient, err := httputil.DecorateClient(basicClient(), allOptions...)
 	if err != nil {
@@ -34,6 +55,11 @@ func Client(atlasDomain string, connection Connection, log *zap.SugaredLogger, o
 	return *client, nil
 }
 
+// tokenURL returns the endpoint issuing the access tokens of the service accounts for the Atlas domain
+func tokenURL(atlasDomain string) string {
+	return strings.TrimSuffix(atlasDomain, "/") + "/api/oauth/token"
+}
+
 func basicClient() *http.Client {
 	// Do we need any custom configuration of timeout etc?
 	return &http.Client{Transport: http.DefaultTransport}
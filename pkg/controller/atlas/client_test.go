@@ -2,12 +2,16 @@ package atlas_test
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
@@ -17,7 +21,7 @@ import (
 func TestClientUserAgent(t *testing.T) {
 	r := require.New(t)
 
-	c, err := atlas.Client("https://cloud.mongodb.com", atlas.Connection{}, nil)
+	c, err := atlas.Client("https://cloud.mongodb.com", atlas.Connection{}, nil, nil)
 	r.NoError(err)
 	r.Contains(c.UserAgent, version.Version)
 }
@@ -29,14 +33,54 @@ type testTransport struct {
 
 func (tt *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	tt.used = true
-	return nil, nil
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
 }
 
 func TestCustomTransport(t *testing.T) {
 	tt := &testTransport{used: false}
 
-	client, err := atlas.Client("https://cloud.mongodb.com", atlas.Connection{}, nil, httputil.CustomTransport(tt))
+	client, err := atlas.Client("https://cloud.mongodb.com", atlas.Connection{}, nil, zap.S(), httputil.CustomTransport(tt))
 	require.NoError(t, err)
 	client.Projects.GetAllProjects(context.Background(), &mongodbatlas.ListOptions{})
 	assert.True(t, tt.used)
 }
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	rt    http.RoundTripper
+	count int
+}
+
+func (ct *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ct.count++
+	return ct.rt.RoundTrip(req)
+}
+
+func TestClientOptionsSeeEveryAttempt(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"results":[],"totalCount":0}`))
+	}))
+	defer server.Close()
+
+	counter := &countingTransport{}
+	withCounter := func(c *http.Client) error {
+		counter.rt = c.Transport
+		c.Transport = counter
+		return nil
+	}
+	client, err := atlas.Client(server.URL, atlas.Connection{}, httputil.NewRateLimiter(0, 1), zap.S(), withCounter)
+	require.NoError(t, err)
+
+	_, _, err = client.Projects.GetAllProjects(context.Background(), &mongodbatlas.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 2, counter.count)
+}
@@ -3,71 +3,340 @@ package atlas
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
+)
+
+const (
+	orgIDKey        = "orgId"
+	publicAPIKey    = "publicApiKey"
+	privateAPIKey   = "privateApiKey"
+	clientIDKey     = "clientId"
+	clientSecretKey = "clientSecret"
+
+	// DefaultCredentialsLabelKey marks the Secret with the Atlas API credentials used by the AtlasProjects of its
+	// namespace for the fields missing in their connection Secret. Like the other credential Secrets, the Secret must
+	// also be labelled with 'atlas.mongodb.com/type=credentials' to be visible to the Operator.
+	DefaultCredentialsLabelKey = "atlas.mongodb.com/default-credentials"
+	DefaultCredentialsLabelVal = "true"
 )
 
+// CredentialsSource is the level the Atlas API credentials are resolved at. The credentials are resolved in the order:
+// the AtlasProject connection Secret, the default Secret of the AtlasProject namespace, the Operator global Secret (or
+// the Operator credentials directory if configured, see ConfigureCredentialsDir).
+// Each of the fields is taken from the first source containing it, so the sources may hold only part of the credentials.
+type CredentialsSource string
+
+const (
+	ProjectCredentials   CredentialsSource = "AtlasProject"
+	NamespaceCredentials CredentialsSource = "Namespace"
+	OperatorCredentials  CredentialsSource = "Operator"
+	FileCredentials      CredentialsSource = "File"
+)
+
+// FieldSource is the Secret or the directory a field of the connection was read from
+type FieldSource struct {
+	Source    CredentialsSource
+	SecretRef client.ObjectKey
+	// Path is the directory the field was read from if the Source is FileCredentials
+	Path string
+}
+
+func (s FieldSource) String() string {
+	if s.Source == FileCredentials {
+		return fmt.Sprintf("%s %s", s.Source, s.Path)
+	}
+	return fmt.Sprintf("%s Secret %s", s.Source, s.SecretRef)
+}
+
+// level returns the position of the source in the resolution order, the fields not set go last
+func (s FieldSource) level() int {
+	switch s.Source {
+	case ProjectCredentials:
+		return 0
+	case NamespaceCredentials:
+		return 1
+	case OperatorCredentials, FileCredentials:
+		return 2
+	}
+	return 3
+}
+
+// ConnectionSources are the sources the fields of the connection were read from
+type ConnectionSources struct {
+	OrgID        FieldSource
+	PublicKey    FieldSource
+	PrivateKey   FieldSource
+	ClientID     FieldSource
+	ClientSecret FieldSource
+}
+
+// AuthMode is the way the requests to the Atlas API are authenticated
+type AuthMode string
+
 const (
-	orgIDKey      = "orgId"
-	publicAPIKey  = "publicApiKey"
-	privateAPIKey = "privateApiKey"
+	// APIKeyAuth authenticates the requests with the programmatic API keys using the HTTP digest authentication
+	APIKeyAuth AuthMode = "APIKey"
+	// ServiceAccountAuth authenticates the requests with the access tokens of the service account obtained by the
+	// OAuth 2.0 client credentials flow
+	ServiceAccountAuth AuthMode = "ServiceAccount"
 )
 
-// Connection encapsulates Atlas connectivity information that is necessary to perform API requests
+// Connection encapsulates Atlas connectivity information that is necessary to perform API requests.
+// The connection holds either the programmatic API keys or the service account credentials, see AuthMode.
 type Connection struct {
-	OrgID      string
-	PublicKey  string
-	PrivateKey string
+	OrgID        string
+	PublicKey    string
+	PrivateKey   string
+	ClientID     string
+	ClientSecret string
+
+	// Transport is the proxy, the CA bundle and the client certificate used to connect to Atlas
+	Transport httputil.TransportConfig
+
+	// Sources are the Secrets or the directory the fields were read from
+	Sources ConnectionSources
+
+	// sources identify the versions of the Secrets, the ConfigMaps and the directory the connection was read from
+	sources []sourceVersion
+}
+
+type sourceVersion struct {
+	// name is the 'namespace/name' of the Secret or the path of the directory
+	name    string
+	version string
 }
 
-// ReadConnection reads Atlas API connection parameters from AtlasProject Secret or from the default Operator one if the
-// former is not specified
-func ReadConnection(log *zap.SugaredLogger, kubeClient client.Client, operatorAPISecret client.ObjectKey, projectOverrideSecretRef *client.ObjectKey) (Connection, error) {
+// ReadConnection reads Atlas API connection parameters from AtlasProject Secret, the default Secret of the AtlasProject
+// namespace and the default Operator one. The fields missing in the AtlasProject Secret (or all of them if the Secret
+// is not specified) are inherited from the namespace Secret, and then from the Operator one. The Operator credentials
+// are read from the files of the directory instead of the Secret if the directory is configured.
+// The transport configuration is read from the AtlasProject Secret or ConfigMap, or the Operator one if configured.
+func ReadConnection(log *zap.SugaredLogger, kubeClient client.Client, operatorAPISecret client.ObjectKey, namespace string, projectOverrideSecretRef *client.ObjectKey, transportRef *common.TransportRef) (Connection, error) {
+	connection, err := readCredentials(log, kubeClient, operatorAPISecret, namespace, projectOverrideSecretRef)
+	if err != nil {
+		return Connection{}, err
+	}
+	if err = connection.readTransport(kubeClient, namespace, transportRef); err != nil {
+		return Connection{}, err
+	}
+	return connection, nil
+}
+
+func readCredentials(log *zap.SugaredLogger, kubeClient client.Client, operatorAPISecret client.ObjectKey, namespace string, projectOverrideSecretRef *client.ObjectKey) (Connection, error) {
+	connection := Connection{}
 	if projectOverrideSecretRef != nil {
-		// TODO is it possible that part of connection (like orgID is still in the Operator level secret and needs to get merged?)
 		log.Infof("Reading Atlas API credentials from the AtlasProject Secret %s", projectOverrideSecretRef)
-		return readAtlasConnectionFromSecret(kubeClient, *projectOverrideSecretRef)
+		if err := connection.merge(kubeClient, *projectOverrideSecretRef, ProjectCredentials); err != nil {
+			return Connection{}, err
+		}
+		if len(connection.missingFields()) == 0 {
+			return connection, nil
+		}
 	}
 
-	log.Debugf("AtlasProject connection Secret is not specified - using the Operator one: %v", operatorAPISecret)
-	return readAtlasConnectionFromSecret(kubeClient, operatorAPISecret)
+	namespaceSecretRef, err := namespaceDefaultSecret(kubeClient, namespace)
+	if err != nil {
+		return Connection{}, err
+	}
+	if namespaceSecretRef != nil {
+		log.Debugf("Reading the missing Atlas API credentials from the default Secret of the namespace: %v", namespaceSecretRef)
+		if err = connection.merge(kubeClient, *namespaceSecretRef, NamespaceCredentials); err != nil {
+			return Connection{}, err
+		}
+		if len(connection.missingFields()) == 0 {
+			return connection, nil
+		}
+	}
+
+	if dir := currentCredentialsDir(); dir != "" {
+		log.Debugf("Reading the missing Atlas API credentials from the Operator directory: %s", dir)
+		err = connection.mergeDir(dir)
+	} else {
+		log.Debugf("Reading the missing Atlas API credentials from the Operator Secret: %v", operatorAPISecret)
+		err = connection.merge(kubeClient, operatorAPISecret, OperatorCredentials)
+	}
+	if err != nil {
+		if validationErr := validateConnection(connection); len(connection.sources) > 0 && validationErr != nil {
+			return Connection{}, fmt.Errorf("%s, they can't be inherited: %w", validationErr, err)
+		}
+		return Connection{}, err
+	}
+	if err = validateConnection(connection); err != nil {
+		return Connection{}, err
+	}
+	return connection, nil
 }
 
-func readAtlasConnectionFromSecret(kubeClient client.Client, secretRef client.ObjectKey) (Connection, error) {
+// namespaceDefaultSecret returns the default credentials Secret of the namespace or nil if there is none
+func namespaceDefaultSecret(kubeClient client.Client, namespace string) (*client.ObjectKey, error) {
+	if namespace == "" {
+		return nil, nil
+	}
+
+	secrets := &corev1.SecretList{}
+	err := kubeClient.List(context.Background(), secrets,
+		client.InNamespace(namespace),
+		client.MatchingLabels{DefaultCredentialsLabelKey: DefaultCredentialsLabelVal},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't list the default Atlas API credentials of the namespace %s: %w", namespace, err)
+	}
+
+	switch len(secrets.Items) {
+	case 0:
+		return nil, nil
+	case 1:
+		key := client.ObjectKeyFromObject(&secrets.Items[0])
+		return &key, nil
+	}
+	names := make([]string, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		names = append(names, secrets.Items[i].Name)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("there are multiple Secrets labelled with %s=%s in the namespace %s: %v",
+		DefaultCredentialsLabelKey, DefaultCredentialsLabelVal, namespace, names)
+}
+
+// merge fills the fields of the connection which are not set yet from the Secret. The empty values are ignored.
+func (c *Connection) merge(kubeClient client.Client, secretRef client.ObjectKey, source CredentialsSource) error {
 	secret := &corev1.Secret{}
 	if err := kubeClient.Get(context.Background(), secretRef, secret); err != nil {
-		return Connection{}, fmt.Errorf("can't read Atlas API credentials from the Secret %v: %w", secretRef, err)
+		return fmt.Errorf("can't read Atlas API credentials from the Secret %v: %w", secretRef, err)
 	}
-	secretData := make(map[string]string)
+
+	data := make(map[string]string, len(secret.Data))
 	for k, v := range secret.Data {
-		secretData[k] = string(v)
+		data[k] = string(v)
 	}
+	c.mergeData(data, FieldSource{Source: source, SecretRef: secretRef})
+	c.sources = append(c.sources, sourceVersion{name: secretRef.String(), version: secret.ResourceVersion})
+	return nil
+}
 
-	if err := validateConnectionSecret(secretRef, secretData); err != nil {
-		return Connection{}, err
+// mergeDir fills the fields of the connection which are not set yet from the files of the directory
+func (c *Connection) mergeDir(dir string) error {
+	data, version, err := readCredentialsDir(dir)
+	if err != nil {
+		return err
 	}
+	c.mergeData(data, FieldSource{Source: FileCredentials, Path: dir})
+	c.sources = append(c.sources, sourceVersion{name: dir, version: version})
+	return nil
+}
 
-	return Connection{
-		OrgID:      secretData["orgId"],
-		PublicKey:  secretData["publicApiKey"],
-		PrivateKey: secretData["privateApiKey"],
-	}, nil
+func (c *Connection) mergeData(data map[string]string, fieldSource FieldSource) {
+	fields := []struct {
+		key    string
+		value  *string
+		source *FieldSource
+	}{
+		{orgIDKey, &c.OrgID, &c.Sources.OrgID},
+		{publicAPIKey, &c.PublicKey, &c.Sources.PublicKey},
+		{privateAPIKey, &c.PrivateKey, &c.Sources.PrivateKey},
+		{clientIDKey, &c.ClientID, &c.Sources.ClientID},
+		{clientSecretKey, &c.ClientSecret, &c.Sources.ClientSecret},
+	}
+	for _, field := range fields {
+		value := data[field.key]
+		if *field.value != "" || value == "" {
+			continue
+		}
+		*field.value = value
+		*field.source = fieldSource
+	}
 }
 
-func validateConnectionSecret(secretRef client.ObjectKey, secretData map[string]string) error {
-	var missingFields []string
-	requiredKeys := []string{orgIDKey, publicAPIKey, privateAPIKey}
+// AuthMode returns the way the connection authenticates. The service account is used if its credentials come from a
+// more specific source than the API keys (e.g. the AtlasProject Secret instead of the Operator one), or the same one.
+func (c *Connection) AuthMode() AuthMode {
+	serviceAccountLevel := min(c.Sources.ClientID.level(), c.Sources.ClientSecret.level())
+	apiKeyLevel := min(c.Sources.PublicKey.level(), c.Sources.PrivateKey.level())
+	if c.ClientID == "" && c.ClientSecret == "" || serviceAccountLevel > apiKeyLevel {
+		return APIKeyAuth
+	}
+	return ServiceAccountAuth
+}
+
+// DescribeSources describes the sources of the fields used for the AuthMode of the connection, the values of the
+// fields are never included
+func (c *Connection) DescribeSources() string {
+	keys := []string{orgIDKey, publicAPIKey, privateAPIKey}
+	sources := []FieldSource{c.Sources.OrgID, c.Sources.PublicKey, c.Sources.PrivateKey}
+	if c.AuthMode() == ServiceAccountAuth {
+		keys = []string{orgIDKey, clientIDKey, clientSecretKey}
+		sources = []FieldSource{c.Sources.OrgID, c.Sources.ClientID, c.Sources.ClientSecret}
+	}
+	descriptions := make([]string, 0, len(keys))
+	for i, key := range keys {
+		descriptions = append(descriptions, fmt.Sprintf("%s from %s", key, sources[i]))
+	}
+	return fmt.Sprintf("%s authentication: %s", c.AuthMode(), strings.Join(descriptions, ", "))
+}
 
-	for _, key := range requiredKeys {
-		if _, ok := secretData[key]; !ok {
-			missingFields = append(missingFields, key)
+func (c *Connection) missingFields() []string {
+	var missingFields []string
+	if c.OrgID == "" {
+		missingFields = append(missingFields, orgIDKey)
+	}
+	if c.AuthMode() == ServiceAccountAuth {
+		if c.ClientID == "" {
+			missingFields = append(missingFields, clientIDKey)
+		}
+		if c.ClientSecret == "" {
+			missingFields = append(missingFields, clientSecretKey)
 		}
+		return missingFields
+	}
+	if c.PublicKey == "" {
+		missingFields = append(missingFields, publicAPIKey)
 	}
+	if c.PrivateKey == "" {
+		missingFields = append(missingFields, privateAPIKey)
+	}
+	return missingFields
+}
 
-	if len(missingFields) > 0 {
-		return fmt.Errorf("the following fields are missing in the Secret %v: %v", secretRef, missingFields)
+// rateLimitKey identifies the organization the requests are sent on behalf of, the credentials identify it if the
+// organization ID isn't known
+func (c *Connection) rateLimitKey() string {
+	if c.OrgID != "" {
+		return c.OrgID
 	}
-	return nil
+	if c.AuthMode() == ServiceAccountAuth {
+		return "clientId:" + c.ClientID
+	}
+	return "publicKey:" + c.PublicKey
+}
+
+// cacheKey returns the Secrets and the directory the connection was read from and their versions
+func (c *Connection) cacheKey() (sources string, versions string) {
+	names := make([]string, 0, len(c.sources))
+	sourceVersions := make([]string, 0, len(c.sources))
+	for _, source := range c.sources {
+		names = append(names, source.name)
+		sourceVersions = append(sourceVersions, source.version)
+	}
+	return strings.Join(names, ","), strings.Join(sourceVersions, ",")
+}
+
+func validateConnection(connection Connection) error {
+	missingFields := connection.missingFields()
+	if len(missingFields) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(connection.sources))
+	for _, source := range connection.sources {
+		names = append(names, source.name)
+	}
+	return fmt.Errorf("the following fields are missing in the Atlas API credentials read from %v: %v", names, missingFields)
 }
@@ -4,19 +4,211 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 )
 
-func Test_validateConnectionSecret(t *testing.T) {
-	err := validateConnectionSecret(kube.ObjectKey("testNs", "testSecret"), map[string]string{})
-	assert.EqualError(t, err, "the following fields are missing in the Secret testNs/testSecret: [orgId publicApiKey privateApiKey]")
+func Test_validateConnection(t *testing.T) {
+	sources := []sourceVersion{{name: "testNs/testSecret"}}
 
-	err = validateConnectionSecret(kube.ObjectKey("testNs", "testSecret"), map[string]string{"publicApiKey": "foo"})
-	assert.EqualError(t, err, "the following fields are missing in the Secret testNs/testSecret: [orgId privateApiKey]")
+	err := validateConnection(Connection{sources: sources})
+	assert.EqualError(t, err, "the following fields are missing in the Atlas API credentials read from [testNs/testSecret]: [orgId publicApiKey privateApiKey]")
 
-	err = validateConnectionSecret(kube.ObjectKey("testNs", "testSecret"), map[string]string{"orgId": "some", "publicApiKey": "foo"})
-	assert.EqualError(t, err, "the following fields are missing in the Secret testNs/testSecret: [privateApiKey]")
+	err = validateConnection(Connection{PublicKey: "foo", sources: sources})
+	assert.EqualError(t, err, "the following fields are missing in the Atlas API credentials read from [testNs/testSecret]: [orgId privateApiKey]")
 
-	assert.NoError(t, validateConnectionSecret(kube.ObjectKey("testNs", "testSecret"), map[string]string{"orgId": "some", "publicApiKey": "foo", "privateApiKey": "bla"}))
+	err = validateConnection(Connection{OrgID: "some", PublicKey: "foo", sources: sources})
+	assert.EqualError(t, err, "the following fields are missing in the Atlas API credentials read from [testNs/testSecret]: [privateApiKey]")
+
+	assert.NoError(t, validateConnection(Connection{OrgID: "some", PublicKey: "foo", PrivateKey: "bla", sources: sources}))
+
+	err = validateConnection(Connection{OrgID: "some", ClientID: "id", sources: sources})
+	assert.EqualError(t, err, "the following fields are missing in the Atlas API credentials read from [testNs/testSecret]: [clientSecret]")
+
+	assert.NoError(t, validateConnection(Connection{OrgID: "some", ClientID: "id", ClientSecret: "secret", sources: sources}))
+}
+
+func TestConnectionAuthMode(t *testing.T) {
+	project := FieldSource{Source: ProjectCredentials}
+	operator := FieldSource{Source: OperatorCredentials}
+
+	testCases := []struct {
+		title      string
+		connection Connection
+		expected   AuthMode
+	}{
+		{
+			title:      "API keys",
+			connection: Connection{PublicKey: "public", PrivateKey: "private", Sources: ConnectionSources{PublicKey: project, PrivateKey: project}},
+			expected:   APIKeyAuth,
+		},
+		{
+			title:      "Service account",
+			connection: Connection{ClientID: "id", ClientSecret: "secret", Sources: ConnectionSources{ClientID: project, ClientSecret: project}},
+			expected:   ServiceAccountAuth,
+		},
+		{
+			title: "Service account of the project takes precedence over the Operator API keys",
+			connection: Connection{
+				PublicKey: "public", PrivateKey: "private", ClientID: "id", ClientSecret: "secret",
+				Sources: ConnectionSources{PublicKey: operator, PrivateKey: operator, ClientID: project, ClientSecret: project},
+			},
+			expected: ServiceAccountAuth,
+		},
+		{
+			title: "API keys of the project take precedence over the Operator service account",
+			connection: Connection{
+				PublicKey: "public", PrivateKey: "private", ClientID: "id", ClientSecret: "secret",
+				Sources: ConnectionSources{PublicKey: project, PrivateKey: project, ClientID: operator, ClientSecret: operator},
+			},
+			expected: APIKeyAuth,
+		},
+		{
+			title: "Service account is preferred in the same source",
+			connection: Connection{
+				PublicKey: "public", PrivateKey: "private", ClientID: "id", ClientSecret: "secret",
+				Sources: ConnectionSources{PublicKey: project, PrivateKey: project, ClientID: project, ClientSecret: project},
+			},
+			expected: ServiceAccountAuth,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.connection.AuthMode())
+		})
+	}
+}
+
+func TestConnectionRateLimitKey(t *testing.T) {
+	project := FieldSource{Source: ProjectCredentials}
+
+	assert.Equal(t, "org", (&Connection{OrgID: "org", PublicKey: "public"}).rateLimitKey())
+	assert.Equal(t, "publicKey:public", (&Connection{PublicKey: "public", PrivateKey: "private"}).rateLimitKey())
+	assert.Equal(t, "clientId:id", (&Connection{
+		ClientID: "id", ClientSecret: "secret",
+		Sources: ConnectionSources{ClientID: project, ClientSecret: project},
+	}).rateLimitKey())
+}
+
+func TestReadConnection(t *testing.T) {
+	credentials := func(namespace, name string, data map[string]string, labels map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+	allFields := func(prefix string) map[string]string {
+		return map[string]string{orgIDKey: prefix + "-org", publicAPIKey: prefix + "-public", privateAPIKey: prefix + "-private"}
+	}
+	defaultLabels := map[string]string{DefaultCredentialsLabelKey: DefaultCredentialsLabelVal}
+	operatorSecret := kube.ObjectKey("operator", "api-key")
+	projectSecret := kube.ObjectKey("ns", "project-key")
+	keysOnlySecret := kube.ObjectKey("ns", "keys-only")
+	namespaceSecret := kube.ObjectKey("ns", "default-key")
+	kubeClient := fake.NewClientBuilder().WithObjects(
+		credentials("operator", "api-key", allFields("operator"), nil),
+		credentials("ns", "project-key", allFields("project"), nil),
+		credentials("ns", "keys-only", map[string]string{publicAPIKey: "keys-public", privateAPIKey: "keys-private", orgIDKey: ""}, nil),
+		credentials("ns", "default-key", map[string]string{orgIDKey: "namespace-org", publicAPIKey: "namespace-public"}, defaultLabels),
+		credentials("other-ns", "org-only", map[string]string{orgIDKey: "other-org"}, nil),
+		credentials("ambiguous", "first", allFields("first"), defaultLabels),
+		credentials("ambiguous", "second", allFields("second"), defaultLabels),
+		credentials("ns", "service-account", map[string]string{clientIDKey: "sa-id", clientSecretKey: "sa-secret"}, nil),
+	).Build()
+	project := func(secretRef client.ObjectKey) FieldSource {
+		return FieldSource{Source: ProjectCredentials, SecretRef: secretRef}
+	}
+	namespace := FieldSource{Source: NamespaceCredentials, SecretRef: namespaceSecret}
+	operator := FieldSource{Source: OperatorCredentials, SecretRef: operatorSecret}
+
+	testCases := []struct {
+		title           string
+		namespace       string
+		projectSecret   *client.ObjectKey
+		expected        Connection
+		expectedSources ConnectionSources
+	}{
+		{
+			title:           "Project Secret takes precedence",
+			namespace:       "ns",
+			projectSecret:   &projectSecret,
+			expected:        Connection{OrgID: "project-org", PublicKey: "project-public", PrivateKey: "project-private"},
+			expectedSources: ConnectionSources{OrgID: project(projectSecret), PublicKey: project(projectSecret), PrivateKey: project(projectSecret)},
+		},
+		{
+			title:           "Namespace default Secret is merged with the Operator one",
+			namespace:       "ns",
+			expected:        Connection{OrgID: "namespace-org", PublicKey: "namespace-public", PrivateKey: "operator-private"},
+			expectedSources: ConnectionSources{OrgID: namespace, PublicKey: namespace, PrivateKey: operator},
+		},
+		{
+			title:           "Empty fields of the project Secret are inherited",
+			namespace:       "ns",
+			projectSecret:   &keysOnlySecret,
+			expected:        Connection{OrgID: "namespace-org", PublicKey: "keys-public", PrivateKey: "keys-private"},
+			expectedSources: ConnectionSources{OrgID: namespace, PublicKey: project(keysOnlySecret), PrivateKey: project(keysOnlySecret)},
+		},
+		{
+			title:           "Operator Secret",
+			namespace:       "other-ns",
+			expected:        Connection{OrgID: "operator-org", PublicKey: "operator-public", PrivateKey: "operator-private"},
+			expectedSources: ConnectionSources{OrgID: operator, PublicKey: operator, PrivateKey: operator},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, tc.namespace, tc.projectSecret, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.OrgID, connection.OrgID)
+			assert.Equal(t, tc.expected.PublicKey, connection.PublicKey)
+			assert.Equal(t, tc.expected.PrivateKey, connection.PrivateKey)
+			assert.Equal(t, tc.expectedSources, connection.Sources)
+		})
+	}
+
+	t.Run("Sources description doesn't contain the values", func(t *testing.T) {
+		connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", &keysOnlySecret, nil)
+		require.NoError(t, err)
+
+		description := connection.DescribeSources()
+		assert.Equal(t, "APIKey authentication: orgId from Namespace Secret ns/default-key, publicApiKey from AtlasProject Secret ns/keys-only, "+
+			"privateApiKey from AtlasProject Secret ns/keys-only", description)
+		assert.NotContains(t, description, "keys-public")
+		assert.NotContains(t, description, "keys-private")
+	})
+
+	t.Run("Service account of the project Secret", func(t *testing.T) {
+		serviceAccount := kube.ObjectKey("ns", "service-account")
+		connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", &serviceAccount, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, ServiceAccountAuth, connection.AuthMode())
+		assert.Equal(t, "sa-id", connection.ClientID)
+		assert.Equal(t, "sa-secret", connection.ClientSecret)
+		assert.Equal(t, "ServiceAccount authentication: orgId from Namespace Secret ns/default-key, "+
+			"clientId from AtlasProject Secret ns/service-account, clientSecret from AtlasProject Secret ns/service-account",
+			connection.DescribeSources())
+	})
+
+	t.Run("Missing fields can't be inherited", func(t *testing.T) {
+		orgOnly := kube.ObjectKey("other-ns", "org-only")
+		_, err := ReadConnection(zap.S(), kubeClient, kube.ObjectKey("operator", "missing"), "other-ns", &orgOnly, nil)
+		assert.ErrorContains(t, err, "the following fields are missing in the Atlas API credentials read from "+
+			"[other-ns/org-only]: [publicApiKey privateApiKey], they can't be inherited: can't read Atlas API credentials from the Secret operator/missing")
+	})
+
+	t.Run("Multiple namespace default Secrets", func(t *testing.T) {
+		_, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ambiguous", nil, nil)
+		assert.EqualError(t, err, "there are multiple Secrets labelled with atlas.mongodb.com/default-credentials=true in the namespace ambiguous: [first second]")
+	})
 }
@@ -0,0 +1,139 @@
+package atlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	// The errors that Atlas API returns if the request is sent from an IP address missing in the access list of the key
+	IPAddressNotOnAccessList = "IP_ADDRESS_NOT_ON_ACCESS_LIST"
+	OrgRequiresAccessList    = "ORG_REQUIRES_ACCESS_LIST"
+
+	orgOwnerRole     = "ORG_OWNER"
+	projectOwnerRole = "GROUP_OWNER"
+)
+
+// CredentialsProblem is the reason the Atlas API credentials can't be used to manage the project
+type CredentialsProblem string
+
+const (
+	// CredentialsInvalid means the API key is wrong, expired or deleted
+	CredentialsInvalid CredentialsProblem = "InvalidKey"
+	// CredentialsMissingRole means the API key has neither the Project Owner role in the project nor the Organization
+	// Owner role in its organization
+	CredentialsMissingRole CredentialsProblem = "MissingRole"
+	// CredentialsIPNotAllowed means the IP address of the Operator is missing in the access list of the API key
+	CredentialsIPNotAllowed CredentialsProblem = "IPNotAllowed"
+)
+
+// CredentialsError is the error returned by the CredentialsChecker if the credentials can't be used to manage the project
+type CredentialsError struct {
+	Problem CredentialsProblem
+	Message string
+}
+
+func (e *CredentialsError) Error() string {
+	return e.Message
+}
+
+// CredentialsChecker verifies the Atlas API credentials before the project is reconciled. The successful checks and
+// the invalid keys are cached by the versions of the Secrets the credentials are read from, while the missing roles
+// and IP addresses are checked again on every call as they are fixed in Atlas without any change of the Secrets.
+// A nil CredentialsChecker checks the credentials on every call.
+type CredentialsChecker struct {
+	mu      sync.Mutex
+	checked map[string]checkedCredentials
+}
+
+type checkedCredentials struct {
+	versions string
+	err      error
+}
+
+// rootResource is the response of the root of the Atlas API describing the API key the request is sent with.
+// The key is not reported for the service accounts.
+type rootResource struct {
+	APIKey *mongodbatlas.APIKey `json:"apiKey,omitempty"`
+}
+
+// NewCredentialsChecker creates an empty CredentialsChecker
+func NewCredentialsChecker() *CredentialsChecker {
+	return &CredentialsChecker{checked: map[string]checkedCredentials{}}
+}
+
+// Check verifies that the credentials of the connection can be used to manage the project, returns a CredentialsError
+// if they can't. The roles of the API key are checked only once the ID of the project is known.
+func (c *CredentialsChecker) Check(ctx context.Context, atlasClient *mongodbatlas.Client, connection Connection, projectID string) error {
+	if c == nil || len(connection.sources) == 0 {
+		return checkCredentials(ctx, atlasClient, connection, projectID)
+	}
+
+	sources, versions := connection.cacheKey()
+	key := sources + "/" + projectID
+	c.mu.Lock()
+	cached, ok := c.checked[key]
+	c.mu.Unlock()
+	if ok && cached.versions == versions {
+		return cached.err
+	}
+
+	err := checkCredentials(ctx, atlasClient, connection, projectID)
+	var credentialsErr *CredentialsError
+	if err == nil || errors.As(err, &credentialsErr) && credentialsErr.Problem == CredentialsInvalid {
+		c.mu.Lock()
+		c.checked[key] = checkedCredentials{versions: versions, err: err}
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func checkCredentials(ctx context.Context, atlasClient *mongodbatlas.Client, connection Connection, projectID string) error {
+	req, err := atlasClient.NewRequest(ctx, http.MethodGet, "/api/atlas/v1.0", nil)
+	if err != nil {
+		return err
+	}
+	root := rootResource{}
+	if _, err = atlasClient.Do(ctx, req, &root); err != nil {
+		return credentialsError(err)
+	}
+
+	if root.APIKey == nil || projectID == "" {
+		return nil
+	}
+	for _, role := range root.APIKey.Roles {
+		if role.RoleName == orgOwnerRole && role.OrgID == connection.OrgID ||
+			role.RoleName == projectOwnerRole && role.GroupID == projectID {
+			return nil
+		}
+	}
+	return &CredentialsError{
+		Problem: CredentialsMissingRole,
+		Message: fmt.Sprintf("the Atlas API key %s has neither the Project Owner role in the project %s nor the Organization Owner role in the organization %s",
+			root.APIKey.PublicKey, projectID, connection.OrgID),
+	}
+}
+
+// credentialsError converts the error of the request to the CredentialsError if it's caused by the credentials
+func credentialsError(err error) error {
+	var apiError *mongodbatlas.ErrorResponse
+	if !errors.As(err, &apiError) {
+		return err
+	}
+	statusCode := apiError.HTTPCode
+	if apiError.Response != nil {
+		statusCode = apiError.Response.StatusCode
+	}
+	switch {
+	case apiError.ErrorCode == IPAddressNotOnAccessList || apiError.ErrorCode == OrgRequiresAccessList:
+		return &CredentialsError{Problem: CredentialsIPNotAllowed, Message: fmt.Sprintf("the IP address of the Operator is not allowed to use the Atlas API key: %s", err)}
+	case statusCode == http.StatusUnauthorized:
+		return &CredentialsError{Problem: CredentialsInvalid, Message: fmt.Sprintf("the Atlas API key is invalid, expired or deleted: %s", err)}
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package atlas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCredentialsChecker(t *testing.T) {
+	var statusCode, requests int
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/atlas/v1.0", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	connection := Connection{OrgID: "org", PublicKey: "public", PrivateKey: "private", sources: []sourceVersion{{name: "ns/keys", version: "1"}}}
+	atlasClient, err := Client(server.URL, connection, nil, zap.S())
+	require.NoError(t, err)
+	respond := func(code int, response string) {
+		statusCode, body, requests = code, response, 0
+	}
+	problem := func(err error) CredentialsProblem {
+		var credentialsErr *CredentialsError
+		if errors.As(err, &credentialsErr) {
+			return credentialsErr.Problem
+		}
+		return ""
+	}
+
+	t.Run("Project Owner", func(t *testing.T) {
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[{"groupId":"project","roleName":"GROUP_OWNER"}]}}`)
+		assert.NoError(t, NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project"))
+	})
+
+	t.Run("Organization Owner", func(t *testing.T) {
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[{"orgId":"org","roleName":"ORG_OWNER"}]}}`)
+		assert.NoError(t, NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project"))
+	})
+
+	t.Run("Roles are not checked before the project is created", func(t *testing.T) {
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[{"orgId":"org","roleName":"ORG_READ_ONLY"}]}}`)
+		assert.NoError(t, NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, ""))
+	})
+
+	t.Run("Missing role", func(t *testing.T) {
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[{"groupId":"project","roleName":"GROUP_READ_ONLY"},{"groupId":"other","roleName":"GROUP_OWNER"}]}}`)
+		err := NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project")
+		assert.Equal(t, CredentialsMissingRole, problem(err))
+		assert.EqualError(t, err, "the Atlas API key public has neither the Project Owner role in the project project nor the Organization Owner role in the organization org")
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		respond(http.StatusUnauthorized, `{"error":401,"reason":"Unauthorized","detail":"You are not authorized for this resource."}`)
+		err := NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project")
+		assert.Equal(t, CredentialsInvalid, problem(err))
+	})
+
+	t.Run("IP address not allowed", func(t *testing.T) {
+		respond(http.StatusForbidden, `{"error":403,"errorCode":"IP_ADDRESS_NOT_ON_ACCESS_LIST","reason":"Forbidden"}`)
+		err := NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project")
+		assert.Equal(t, CredentialsIPNotAllowed, problem(err))
+	})
+
+	t.Run("Other errors are returned as is", func(t *testing.T) {
+		respond(http.StatusInternalServerError, `{"error":500,"reason":"Internal Server Error"}`)
+		err := NewCredentialsChecker().Check(context.Background(), &atlasClient, connection, "project")
+		assert.Error(t, err)
+		assert.Equal(t, CredentialsProblem(""), problem(err))
+	})
+
+	t.Run("Successful check is cached until the Secret changes", func(t *testing.T) {
+		checker := NewCredentialsChecker()
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[{"groupId":"project","roleName":"GROUP_OWNER"}]}}`)
+		require.NoError(t, checker.Check(context.Background(), &atlasClient, connection, "project"))
+		require.NoError(t, checker.Check(context.Background(), &atlasClient, connection, "project"))
+		assert.Equal(t, 1, requests)
+
+		rotated := connection
+		rotated.sources = []sourceVersion{{name: "ns/keys", version: "2"}}
+		require.NoError(t, checker.Check(context.Background(), &atlasClient, rotated, "project"))
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("Missing role is checked again", func(t *testing.T) {
+		checker := NewCredentialsChecker()
+		respond(http.StatusOK, `{"apiKey":{"publicKey":"public","roles":[]}}`)
+		require.Error(t, checker.Check(context.Background(), &atlasClient, connection, "project"))
+		require.Error(t, checker.Check(context.Background(), &atlasClient, connection, "project"))
+		assert.Equal(t, 2, requests)
+	})
+}
@@ -0,0 +1,91 @@
+package atlas
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// credentialsDir is the directory the Operator level Atlas API credentials are read from instead of the global Secret.
+// Empty if the credentials are read from the Secret.
+var credentialsDir atomic.Pointer[string]
+
+// ConfigureCredentialsDir makes the Operator read its Atlas API credentials from the files of the directory instead of
+// the global Secret. The files are named after the keys of the Secret ('orgId', 'publicApiKey' and 'privateApiKey', or
+// 'clientId' and 'clientSecret' for the service account), which is the layout of a mounted Secret or the files
+// rendered by a Vault agent or a CSI secret store driver.
+func ConfigureCredentialsDir(dir string) {
+	credentialsDir.Store(&dir)
+}
+
+func currentCredentialsDir() string {
+	if dir := credentialsDir.Load(); dir != nil {
+		return *dir
+	}
+	return ""
+}
+
+// readCredentialsDir reads the credentials from the files of the directory, the missing files are skipped.
+// The version returned changes once the content of any of the files changes.
+func readCredentialsDir(dir string) (map[string]string, string, error) {
+	data := map[string]string{}
+	hash := sha256.New()
+	for _, key := range []string{orgIDKey, publicAPIKey, privateAPIKey, clientIDKey, clientSecretKey} {
+		content, err := os.ReadFile(filepath.Join(dir, key))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("can't read Atlas API credentials from the file %s: %w", filepath.Join(dir, key), err)
+		}
+		// the files rendered by the templates usually end with a new line
+		data[key] = strings.TrimSpace(string(content))
+		hash.Write([]byte(key + "=" + data[key] + "\n"))
+	}
+	return data, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// WatchCredentialsDir calls onChange every time the files of the directory are changed until the context is done.
+// Both the files written in place and the symlinks swapped by the Kubernetes volumes are watched.
+func WatchCredentialsDir(ctx context.Context, dir string, log *zap.SugaredLogger, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err = watcher.Add(dir); err != nil {
+		return fmt.Errorf("can't watch the Atlas API credentials directory %s: %w", dir, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			log.Debugf("Atlas API credentials directory changed: %s", event)
+			onChange()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Errorf("Failed to watch the Atlas API credentials directory %s: %s", dir, err)
+		}
+	}
+}
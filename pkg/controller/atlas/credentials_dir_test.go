@@ -0,0 +1,84 @@
+package atlas
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+func writeCredentials(t *testing.T, dir string, data map[string]string) {
+	for key, value := range data {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, key), []byte(value), 0o600))
+	}
+}
+
+func TestReadCredentialsDir(t *testing.T) {
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{orgIDKey: "org\n", publicAPIKey: "public"})
+
+	data, version, err := readCredentialsDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{orgIDKey: "org", publicAPIKey: "public"}, data)
+
+	writeCredentials(t, dir, map[string]string{privateAPIKey: "private"})
+	_, rotatedVersion, err := readCredentialsDir(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, version, rotatedVersion)
+}
+
+func TestReadConnectionFromCredentialsDir(t *testing.T) {
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{orgIDKey: "file-org", publicAPIKey: "file-public", privateAPIKey: "file-private"})
+	ConfigureCredentialsDir(dir)
+	defer ConfigureCredentialsDir("")
+
+	projectSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "org"},
+		Data:       map[string][]byte{orgIDKey: []byte("project-org")},
+	}
+	kubeClient := fake.NewClientBuilder().WithObjects(projectSecret).Build()
+	projectSecretRef := kube.ObjectKey("ns", "org")
+
+	// the global Secret doesn't exist, the Operator credentials are read from the directory
+	connection, err := ReadConnection(zap.S(), kubeClient, kube.ObjectKey("operator", "api-key"), "ns", &projectSecretRef, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "project-org", connection.OrgID)
+	assert.Equal(t, "file-public", connection.PublicKey)
+	assert.Equal(t, "file-private", connection.PrivateKey)
+	assert.Equal(t, FieldSource{Source: FileCredentials, Path: dir}, connection.Sources.PrivateKey)
+	assert.Equal(t, "privateApiKey from File "+dir, "privateApiKey from "+connection.Sources.PrivateKey.String())
+}
+
+func TestWatchCredentialsDir(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan struct{}, 10)
+	done := make(chan error)
+	go func() {
+		done <- WatchCredentialsDir(ctx, dir, zap.S(), func() { changes <- struct{}{} })
+	}()
+
+	require.Eventually(t, func() bool {
+		writeCredentials(t, dir, map[string]string{privateAPIKey: "rotated"})
+		select {
+		case <-changes:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,19 @@
+package atlas
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
+)
+
+// apiMetrics record the requests sent to Atlas. They are exposed on the metrics endpoint of the manager.
+var apiMetrics = httputil.NewAPIMetrics("atlas_operator")
+
+func init() {
+	metrics.Registry.MustRegister(apiMetrics.Collectors()...)
+}
+
+// WithMetrics is the option recording the requests sent to Atlas on behalf of the 'controller'
+func WithMetrics(controller string) httputil.ClientOpt {
+	return httputil.Metrics(apiMetrics, controller)
+}
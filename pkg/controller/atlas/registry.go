@@ -0,0 +1,139 @@
+package atlas
+
+import (
+	"container/list"
+	"sync"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
+)
+
+// maxCachedClients bounds the clients kept by a ClientRegistry, the least recently used ones are evicted first
+const maxCachedClients = 256
+
+// ClientRegistry caches the Atlas clients by the connection Secrets they are created from, so that the reconcilers
+// reuse the same client (and transport) as long as the Secrets don't change. The clients of the Secrets not used
+// anymore are evicted once the cache is full.
+// The requests of all the clients sent on behalf of the same organization share the rate limit, whichever controller
+// sends them.
+// A nil ClientRegistry is valid and creates a new client not throttling the requests on every call.
+type ClientRegistry struct {
+	log        *zap.SugaredLogger
+	controller string
+	cache      *clientCache
+}
+
+type clientCache struct {
+	rateLimiters *httputil.RateLimiters
+	// size is the maximum number of clients cached
+	size int
+
+	mu      sync.Mutex
+	clients map[clientKey]*list.Element
+	// lru orders the cachedClients from the most to the least recently used one
+	lru *list.List
+}
+
+type clientKey struct {
+	sources    string
+	controller string
+}
+
+type cachedClient struct {
+	key             clientKey
+	atlasDomain     string
+	resourceVersion string
+	client          mongodbatlas.Client
+}
+
+// NewClientRegistry creates a ClientRegistry. The clients it creates log the requests with the logger and send up to
+// 'requestsPerSecond' requests per organization on average with bursts of 'burst' requests.
+func NewClientRegistry(log *zap.SugaredLogger, requestsPerSecond float64, burst int) *ClientRegistry {
+	return &ClientRegistry{
+		log: log,
+		cache: &clientCache{
+			rateLimiters: httputil.NewRateLimiters(requestsPerSecond, burst),
+			size:         maxCachedClients,
+			clients:      map[clientKey]*list.Element{},
+			lru:          list.New(),
+		},
+	}
+}
+
+// ForController returns a ClientRegistry sharing the cache with this one, whose clients record the requests
+// to the Atlas API metrics on behalf of the 'controller'
+func (r *ClientRegistry) ForController(controller string) *ClientRegistry {
+	return &ClientRegistry{
+		log:        r.log,
+		controller: controller,
+		cache:      r.cache,
+	}
+}
+
+// Client returns the Atlas client for the connection, creating it if any of the connection Secrets changed since the
+// client was cached. Connections not read from a Secret always get a new client.
+func (r *ClientRegistry) Client(atlasDomain string, connection Connection, log *zap.SugaredLogger) (mongodbatlas.Client, error) {
+	if r == nil {
+		return Client(atlasDomain, connection, nil, log)
+	}
+	rateLimiter := r.cache.rateLimiters.Get(connection.rateLimitKey())
+	if len(connection.sources) == 0 {
+		return Client(atlasDomain, connection, rateLimiter, log)
+	}
+
+	r.cache.mu.Lock()
+	defer r.cache.mu.Unlock()
+
+	sources, resourceVersion := connection.cacheKey()
+	key := clientKey{sources: sources, controller: r.controller}
+	if cached := r.cache.get(key); cached != nil && cached.resourceVersion == resourceVersion && cached.atlasDomain == atlasDomain {
+		return cached.client, nil
+	}
+
+	var opts []httputil.ClientOpt
+	if r.controller != "" {
+		opts = append(opts, WithMetrics(r.controller))
+	}
+	atlasClient, err := Client(atlasDomain, connection, rateLimiter, r.log, opts...)
+	if err != nil {
+		return mongodbatlas.Client{}, err
+	}
+
+	r.cache.put(cachedClient{
+		key:             key,
+		atlasDomain:     atlasDomain,
+		resourceVersion: resourceVersion,
+		client:          atlasClient,
+	})
+
+	return atlasClient, nil
+}
+
+// get returns the cached client marking it as the most recently used one, nil if the client isn't cached
+func (c *clientCache) get(key clientKey) *cachedClient {
+	element, ok := c.clients[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(element)
+	return element.Value.(*cachedClient)
+}
+
+// put caches the client replacing the one with the same key, the least recently used client is evicted if the cache
+// is full
+func (c *clientCache) put(client cachedClient) {
+	if element, ok := c.clients[client.key]; ok {
+		element.Value = &client
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.clients[client.key] = c.lru.PushFront(&client)
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.clients, oldest.Value.(*cachedClient).key)
+	}
+}
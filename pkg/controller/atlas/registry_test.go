@@ -0,0 +1,129 @@
+package atlas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClientRegistry(t *testing.T) {
+	secretRef := client.ObjectKey{Namespace: "ns", Name: "api-key"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretRef.Namespace, Name: secretRef.Name},
+		Data: map[string][]byte{
+			orgIDKey:      []byte("org"),
+			publicAPIKey:  []byte("public"),
+			privateAPIKey: []byte("private"),
+		},
+	}
+	kubeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+	log := zap.S()
+	readConnection := func() Connection {
+		connection, err := ReadConnection(log, kubeClient, secretRef, "", nil, nil)
+		require.NoError(t, err)
+		return connection
+	}
+
+	t.Run("Client is reused while the Secret doesn't change", func(t *testing.T) {
+		registry := NewClientRegistry(log, DefaultRequestsPerSecond, DefaultRequestsBurst)
+
+		first, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		second, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+
+		assert.True(t, first.Projects == second.Projects)
+	})
+
+	t.Run("Client is created again when the Secret changes", func(t *testing.T) {
+		registry := NewClientRegistry(log, DefaultRequestsPerSecond, DefaultRequestsBurst)
+
+		first, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+
+		secret.Data[privateAPIKey] = []byte("rotated")
+		require.NoError(t, kubeClient.Update(context.Background(), secret))
+		second, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+
+		assert.False(t, first.Projects == second.Projects)
+	})
+
+	t.Run("Client is created again when the inherited Secret changes", func(t *testing.T) {
+		registry := NewClientRegistry(log, DefaultRequestsPerSecond, DefaultRequestsBurst)
+		projectSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "project-ns", Name: "org"},
+			Data:       map[string][]byte{orgIDKey: []byte("project-org")},
+		}
+		require.NoError(t, kubeClient.Create(context.Background(), projectSecret))
+		projectSecretRef := client.ObjectKeyFromObject(projectSecret)
+		readMergedConnection := func() Connection {
+			connection, err := ReadConnection(log, kubeClient, secretRef, "", &projectSecretRef, nil)
+			require.NoError(t, err)
+			return connection
+		}
+
+		first, err := registry.Client("https://cloud.mongodb.com", readMergedConnection(), log)
+		require.NoError(t, err)
+
+		secret.Data[publicAPIKey] = []byte("rotated")
+		require.NoError(t, kubeClient.Update(context.Background(), secret))
+		second, err := registry.Client("https://cloud.mongodb.com", readMergedConnection(), log)
+		require.NoError(t, err)
+
+		assert.False(t, first.Projects == second.Projects)
+	})
+
+	t.Run("Least recently used client is evicted from the full cache", func(t *testing.T) {
+		registry := NewClientRegistry(log, DefaultRequestsPerSecond, DefaultRequestsBurst)
+		registry.cache.size = 2
+		deployments := registry.ForController("AtlasDeployment")
+		projects := registry.ForController("AtlasProject")
+		users := registry.ForController("AtlasDatabaseUser")
+
+		first, err := deployments.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		_, err = projects.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		_, err = deployments.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		_, err = users.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		assert.Equal(t, 2, registry.cache.lru.Len())
+
+		second, err := deployments.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		assert.True(t, first.Projects == second.Projects, "the recently used client must be kept")
+		assert.Contains(t, registry.cache.clients, clientKey{sources: secretRef.String(), controller: "AtlasDeployment"})
+		assert.NotContains(t, registry.cache.clients, clientKey{sources: secretRef.String(), controller: "AtlasProject"})
+	})
+
+	t.Run("Nil registry creates a client on every call", func(t *testing.T) {
+		var registry *ClientRegistry
+
+		first, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+		second, err := registry.Client("https://cloud.mongodb.com", readConnection(), log)
+		require.NoError(t, err)
+
+		assert.False(t, first.Projects == second.Projects)
+	})
+
+	t.Run("Connection not read from a Secret is not cached", func(t *testing.T) {
+		registry := NewClientRegistry(log, DefaultRequestsPerSecond, DefaultRequestsBurst)
+
+		first, err := registry.Client("https://cloud.mongodb.com", Connection{OrgID: "org"}, log)
+		require.NoError(t, err)
+		second, err := registry.Client("https://cloud.mongodb.com", Connection{OrgID: "org"}, log)
+		require.NoError(t, err)
+
+		assert.False(t, first.Projects == second.Projects)
+	})
+}
@@ -0,0 +1,111 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
+)
+
+const (
+	caBundleKey = "ca.crt"
+	proxyURLKey = "proxyUrl"
+
+	TransportSecretKind    = "Secret"
+	TransportConfigMapKind = "ConfigMap"
+)
+
+// transportSource is the Secret or the ConfigMap the transport configuration is read from
+type transportSource struct {
+	kind string
+	key  client.ObjectKey
+}
+
+// operatorTransport is the Operator transport configuration used by the AtlasProjects not referencing their own one.
+// Nil if the Operator uses the default transport.
+var operatorTransport atomic.Pointer[transportSource]
+
+// ConfigureTransport makes the Operator connect to Atlas with the proxy, the CA bundle and the client certificate of
+// the Secret or the ConfigMap in the namespace, unless the AtlasProject references its own configuration.
+func ConfigureTransport(namespace string, ref *common.TransportRef) {
+	if ref == nil {
+		operatorTransport.Store(nil)
+		return
+	}
+	operatorTransport.Store(&transportSource{kind: transportKind(ref), key: client.ObjectKey{Namespace: namespace, Name: ref.Name}})
+}
+
+// ParseTransportRef parses the reference to the transport configuration in the format '[Secret|ConfigMap/]<name>',
+// the kind is Secret if omitted. Returns nil for the empty value.
+func ParseTransportRef(value string) (*common.TransportRef, error) {
+	if value == "" {
+		return nil, nil
+	}
+	kind, name, found := strings.Cut(value, "/")
+	if !found {
+		kind, name = TransportSecretKind, value
+	}
+	if kind != TransportSecretKind && kind != TransportConfigMapKind || name == "" {
+		return nil, fmt.Errorf("invalid transport reference %q, expected '[%s|%s/]<name>'", value, TransportSecretKind, TransportConfigMapKind)
+	}
+	return &common.TransportRef{Kind: kind, Name: name}, nil
+}
+
+func transportKind(ref *common.TransportRef) string {
+	if ref.Kind == "" {
+		return TransportSecretKind
+	}
+	return ref.Kind
+}
+
+// readTransport reads the transport configuration of the AtlasProject Secret or ConfigMap, or the Operator one if the
+// project doesn't reference any
+func (c *Connection) readTransport(kubeClient client.Client, namespace string, transportRef *common.TransportRef) error {
+	source := operatorTransport.Load()
+	if transportRef != nil {
+		source = &transportSource{kind: transportKind(transportRef), key: client.ObjectKey{Namespace: namespace, Name: transportRef.Name}}
+	}
+	if source == nil {
+		return nil
+	}
+
+	var data map[string][]byte
+	var version string
+	switch source.kind {
+	case TransportConfigMapKind:
+		configMap := &corev1.ConfigMap{}
+		if err := kubeClient.Get(context.Background(), source.key, configMap); err != nil {
+			return fmt.Errorf("can't read the Atlas transport configuration from the ConfigMap %v: %w", source.key, err)
+		}
+		if _, ok := configMap.Data[corev1.TLSPrivateKeyKey]; ok {
+			return fmt.Errorf("the client key of the Atlas transport configuration must be stored in a Secret, not the ConfigMap %v", source.key)
+		}
+		data = make(map[string][]byte, len(configMap.Data))
+		for k, v := range configMap.Data {
+			data[k] = []byte(v)
+		}
+		version = configMap.ResourceVersion
+	default:
+		secret := &corev1.Secret{}
+		if err := kubeClient.Get(context.Background(), source.key, secret); err != nil {
+			return fmt.Errorf("can't read the Atlas transport configuration from the Secret %v: %w", source.key, err)
+		}
+		data = secret.Data
+		version = secret.ResourceVersion
+	}
+
+	c.Transport = httputil.TransportConfig{
+		CABundle:          data[caBundleKey],
+		ProxyURL:          strings.TrimSpace(string(data[proxyURLKey])),
+		ClientCertificate: data[corev1.TLSCertKey],
+		ClientKey:         data[corev1.TLSPrivateKeyKey],
+	}
+	c.sources = append(c.sources, sourceVersion{name: source.kind + " " + source.key.String(), version: version})
+	return nil
+}
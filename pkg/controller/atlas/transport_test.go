@@ -0,0 +1,117 @@
+package atlas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+func TestParseTransportRef(t *testing.T) {
+	ref, err := ParseTransportRef("")
+	require.NoError(t, err)
+	assert.Nil(t, ref)
+
+	ref, err = ParseTransportRef("atlas-proxy")
+	require.NoError(t, err)
+	assert.Equal(t, &common.TransportRef{Kind: "Secret", Name: "atlas-proxy"}, ref)
+
+	ref, err = ParseTransportRef("ConfigMap/atlas-proxy")
+	require.NoError(t, err)
+	assert.Equal(t, &common.TransportRef{Kind: "ConfigMap", Name: "atlas-proxy"}, ref)
+
+	_, err = ParseTransportRef("Pod/atlas-proxy")
+	assert.EqualError(t, err, `invalid transport reference "Pod/atlas-proxy", expected '[Secret|ConfigMap/]<name>'`)
+}
+
+func TestReadConnectionTransport(t *testing.T) {
+	defer ConfigureTransport("", nil)
+
+	apiKeys := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "api-key"},
+		Data:       map[string][]byte{orgIDKey: []byte("org"), publicAPIKey: []byte("public"), privateAPIKey: []byte("private")},
+	}
+	operatorTransport := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "proxy"},
+		Data:       map[string]string{proxyURLKey: "http://operator-proxy:3128\n", caBundleKey: "operator-ca"},
+	}
+	projectTransport := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "mtls"},
+		Data: map[string][]byte{
+			caBundleKey:               []byte("project-ca"),
+			corev1.TLSCertKey:         []byte("project-cert"),
+			corev1.TLSPrivateKeyKey:   []byte("project-key"),
+			"unrelated-configuration": []byte("ignored"),
+		},
+	}
+	keyInConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "key-in-configmap"},
+		Data:       map[string]string{corev1.TLSCertKey: "cert", corev1.TLSPrivateKeyKey: "key"},
+	}
+	kubeClient := fake.NewClientBuilder().WithObjects(apiKeys, operatorTransport, projectTransport, keyInConfigMap).Build()
+	operatorSecret := kube.ObjectKey("operator", "api-key")
+
+	t.Run("Default transport", func(t *testing.T) {
+		connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, nil)
+		require.NoError(t, err)
+		assert.True(t, connection.Transport.IsEmpty())
+	})
+
+	ConfigureTransport("operator", &common.TransportRef{Kind: "ConfigMap", Name: "proxy"})
+
+	t.Run("Operator transport", func(t *testing.T) {
+		connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, httputil.TransportConfig{CABundle: []byte("operator-ca"), ProxyURL: "http://operator-proxy:3128"}, connection.Transport)
+	})
+
+	t.Run("Project transport takes precedence", func(t *testing.T) {
+		connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, &common.TransportRef{Name: "mtls"})
+		require.NoError(t, err)
+		assert.Equal(t, httputil.TransportConfig{
+			CABundle:          []byte("project-ca"),
+			ClientCertificate: []byte("project-cert"),
+			ClientKey:         []byte("project-key"),
+		}, connection.Transport)
+	})
+
+	t.Run("Client is created again when the transport changes", func(t *testing.T) {
+		registry := NewClientRegistry(zap.S(), DefaultRequestsPerSecond, DefaultRequestsBurst)
+		read := func() Connection {
+			connection, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, nil)
+			require.NoError(t, err)
+			// the operator ConfigMap of the test doesn't contain a valid CA bundle
+			connection.Transport.CABundle = nil
+			return connection
+		}
+
+		first, err := registry.Client("https://cloud.mongodb.com", read(), zap.S())
+		require.NoError(t, err)
+
+		operatorTransport.Data[proxyURLKey] = "http://other-proxy:3128"
+		require.NoError(t, kubeClient.Update(context.Background(), operatorTransport))
+		second, err := registry.Client("https://cloud.mongodb.com", read(), zap.S())
+		require.NoError(t, err)
+
+		assert.False(t, first.Projects == second.Projects)
+	})
+
+	t.Run("Client key can't be stored in a ConfigMap", func(t *testing.T) {
+		_, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, &common.TransportRef{Kind: "ConfigMap", Name: "key-in-configmap"})
+		assert.EqualError(t, err, "the client key of the Atlas transport configuration must be stored in a Secret, not the ConfigMap ns/key-in-configmap")
+	})
+
+	t.Run("Missing transport configuration", func(t *testing.T) {
+		_, err := ReadConnection(zap.S(), kubeClient, operatorSecret, "ns", nil, &common.TransportRef{Name: "missing"})
+		assert.ErrorContains(t, err, "can't read the Atlas transport configuration from the Secret ns/missing")
+	})
+}
@@ -29,45 +29,64 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/keylock"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 )
 
 // AtlasDatabaseUserReconciler reconciles an AtlasDatabaseUser object
 type AtlasDatabaseUserReconciler struct {
-	watch.ResourceWatcher
-	Client                      client.Client
-	Log                         *zap.SugaredLogger
-	Scheme                      *runtime.Scheme
-	AtlasDomain                 string
-	GlobalAPISecret             client.ObjectKey
-	EventRecorder               record.EventRecorder
-	GlobalPredicates            []predicate.Predicate
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
+	Client client.Client
+	Log    *zap.SugaredLogger
+	Scheme *runtime.Scheme
+	// Settings are the operator settings which are changed at runtime by the AtlasOperatorConfig
+	Settings                *atlasoperatorconfig.LiveSettings
+	AtlasClients            *atlas.ClientRegistry
+	GlobalAPISecret         client.ObjectKey
+	EventRecorder           record.EventRecorder
+	GlobalPredicates        []predicate.Predicate
+	MaxConcurrentReconciles int
+	ProjectLocks            *keylock.KeyLock
+	// APIReader reads the password and the X.509 certificate Secrets bypassing the cache, which doesn't have the
+	// Secrets created during the reconciliation yet
+	APIReader client.Reader
+	// WatchedNamespaces are the namespaces the connection Secrets may be replicated to, all of them if containing ""
+	WatchedNamespaces map[string]bool
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
-func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (reconcileResult ctrl.Result, _ error) {
+	ctx, span := tracing.Start(ctx, "AtlasDatabaseUser.Reconcile", tracing.ResourceAttributes(req.NamespacedName)...)
+	defer span.End()
+
 	log := r.Log.With("atlasdatabaseuser", req.NamespacedName)
 
 	databaseUser := &mdbv1.AtlasDatabaseUser{}
@@ -82,13 +101,11 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, databaseUser, log)
+	workflowCtx.Context = ctx
 	log.Infow("-> Starting AtlasDatabaseUser reconciliation", "spec", databaseUser.Spec, "status", databaseUser.Status)
-	if databaseUser.Spec.PasswordSecret != nil {
-		workflowCtx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "Secret", Resource: *databaseUser.PasswordSecretObjectKey()})
-	}
 	defer func() {
+		reconcileResult = workflowCtx.ApplyBackoff(reconcileResult)
 		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, databaseUser)
-		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, databaseUser, r.Log)
@@ -106,14 +123,27 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	workflowCtx.SetConditionTrue(status.ValidationSucceeded)
 
+	targets, err := connectionsecret.TargetNamespaces(ctx, r.Client, *databaseUser, r.WatchedNamespaces)
+	if err != nil {
+		if errors.Is(err, connectionsecret.ErrInvalidTargets) {
+			result = workflow.Terminate(workflow.DatabaseUserInvalidTargets, err.Error())
+		} else {
+			result = workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
+		}
+		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
+
+		return result.ReconcileResult(), nil
+	}
+
 	project := &mdbv1.AtlasProject{}
 	if result = r.readProjectResource(databaseUser, project); !result.IsOk() {
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
 
 		return result.ReconcileResult(), nil
 	}
+	defer r.ProjectLocks.Lock(project.ID())()
 
-	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.ConnectionSecretObjectKey())
+	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.Namespace, project.ConnectionSecretObjectKey(), project.Spec.TransportRef)
 	if err != nil {
 		result = workflow.Terminate(workflow.AtlasCredentialsNotProvided, err.Error())
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
@@ -122,7 +152,7 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	workflowCtx.Connection = connection
 
-	atlasClient, err := atlas.Client(r.AtlasDomain, connection, log)
+	atlasClient, err := r.AtlasClients.Client(r.Settings.AtlasDomain(), connection, log)
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, err.Error())
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
@@ -131,7 +161,21 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	workflowCtx.Client = atlasClient
 
-	owner, err := customresource.IsOwner(databaseUser, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient, project.ID(), log))
+	if customresource.ReconciliationShouldBePlanned(databaseUser) {
+		plan, err := planDatabaseUser(workflowCtx, r.Client, *project, *databaseUser)
+		if err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			workflowCtx.SetConditionFromResult(status.ReconciliationPlannedType, result)
+
+			return result.ReconcileResult(), nil
+		}
+		log.Infow("-> Planned AtlasDatabaseUser reconciliation", "plan", plan)
+		workflowCtx.SetPlan(plan)
+
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	owner, err := customresource.IsOwner(databaseUser, r.Settings.ObjectDeletionProtection(), customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient, project.ID(), log))
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("enable to resolve ownership for deletion protection: %s", err))
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
@@ -165,7 +209,7 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return result.ReconcileResult(), nil
 	}
 
-	result = r.ensureDatabaseUser(workflowCtx, *project, *databaseUser)
+	result = r.ensureDatabaseUser(workflowCtx, *project, *databaseUser, targets)
 	if !result.IsOk() {
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
 
@@ -210,9 +254,12 @@ func (r *AtlasDatabaseUserReconciler) handleDeletion(
 		if err != nil {
 			return true, workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotDeleted, err.Error())
 		}
+		if err = connectionsecret.RemoveX509Certificate(r.Client, *dbUser); err != nil {
+			return true, workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotDeleted, err.Error())
+		}
 	}
 
-	if customresource.IsResourceProtected(dbUser, r.ObjectDeletionProtection) {
+	if customresource.IsResourceProtected(dbUser, r.Settings.ObjectDeletionProtection()) {
 		log.Info("Not removing Atlas database user from Atlas as per configuration")
 
 		err := customresource.ManageFinalizer(ctx, r.Client, dbUser, customresource.UnsetFinalizer)
@@ -223,7 +270,7 @@ func (r *AtlasDatabaseUserReconciler) handleDeletion(
 		return true, workflow.OK()
 	}
 
-	_, err := atlasClient.DatabaseUsers.Delete(context.Background(), dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
+	_, err := atlasClient.DatabaseUsers.Delete(ctx, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
 	if err != nil {
 		var apiError *mongodbatlas.ErrorResponse
 		if errors.As(err, &apiError) && apiError.ErrorCode != atlas.UsernameNotFound {
@@ -242,11 +289,46 @@ func (r *AtlasDatabaseUserReconciler) handleDeletion(
 }
 
 func (r *AtlasDatabaseUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mdbv1.AtlasDatabaseUser{}, watch.DatabaseUserSecretsIndex, watch.DatabaseUserSecretRefs); err != nil {
+		return err
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasDatabaseUser").
 		For(&mdbv1.AtlasDatabaseUser{}, builder.WithPredicates(r.GlobalPredicates...)).
-		Watches(&source.Kind{Type: &corev1.Secret{}}, watch.NewSecretHandler(r.WatchedResources)).
-		Complete(r)
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, watch.NewSecretHandler(watch.IndexedDependants(mgr.GetClient(), &mdbv1.AtlasDatabaseUserList{}, watch.DatabaseUserSecretsIndex)))
+	// The namespaces can't be read by the operator watching a single namespace, the Secrets aren't replicated then
+	if connectionsecret.WatchesOtherNamespaces(r.WatchedNamespaces) {
+		controllerBuilder = controllerBuilder.Watches(
+			&source.Kind{Type: &corev1.Namespace{}},
+			handler.EnqueueRequestsFromMapFunc(r.usersTargetingNamespace),
+			builder.WithPredicates(predicate.Or(predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
+		)
+	}
+	return controllerBuilder.Complete(r)
+}
+
+// usersTargetingNamespace returns the database users which replicate or may replicate their connection Secrets to the
+// namespace, so that the changes of its labels or annotations are applied
+func (r *AtlasDatabaseUserReconciler) usersTargetingNamespace(obj client.Object) []reconcile.Request {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		return nil
+	}
+	users := &mdbv1.AtlasDatabaseUserList{}
+	if err := r.Client.List(context.Background(), users); err != nil {
+		r.Log.Errorf("failed to list the AtlasDatabaseUsers targeting the namespace %s: %s", ns.Name, err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range users.Items {
+		if connectionsecret.Targets(users.Items[i], ns) {
+			requests = append(requests, reconcile.Request{NamespacedName: kube.ObjectKeyFromObject(&users.Items[i])})
+		}
+	}
+	return requests
 }
 
 func managedByAtlas(ctx context.Context, atlasClient mongodbatlas.Client, projectID string, log *zap.SugaredLogger) customresource.AtlasChecker {
@@ -23,8 +23,12 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/timeutil"
 )
 
-func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
-	apiUser, err := dbUser.ToAtlas(r.Client)
+func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, targets []string) workflow.Result {
+	if result := ensurePasswordSecret(ctx, r.Client, &dbUser); !result.IsOk() {
+		return result
+	}
+
+	apiUser, err := dbUser.ToAtlas(r.APIReader)
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
@@ -41,11 +45,17 @@ func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context,
 		return result
 	}
 
+	// The result requeues the renewal of the X.509 certificate
+	renewal := ensureX509Certificate(ctx, r.Client, r.APIReader, project.ID(), dbUser)
+	if !renewal.IsOk() {
+		return renewal
+	}
+
 	if result := checkDeploymentsHaveReachedGoalState(ctx, project.ID(), dbUser); !result.IsOk() {
 		return result
 	}
 
-	if result := connectionsecret.CreateOrUpdateConnectionSecrets(ctx, r.Client, r.EventRecorder, project, dbUser); !result.IsOk() {
+	if result := connectionsecret.CreateOrUpdateConnectionSecrets(ctx, r.Client, r.APIReader, r.EventRecorder, project, dbUser, targets); !result.IsOk() {
 		return result
 	}
 
@@ -57,7 +67,7 @@ func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context,
 	// We mark the status.Username only when everything is finished including connection secrets
 	ctx.EnsureStatusOption(status.AtlasDatabaseUserNameOption(dbUser.Spec.Username))
 
-	return workflow.OK()
+	return renewal
 }
 
 func handleUserNameChange(ctx *workflow.Context, projectID string, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
@@ -66,7 +76,7 @@ func handleUserNameChange(ctx *workflow.Context, projectID string, dbUser mdbv1.
 
 		deleteAttempts := 3
 		for i := 1; i <= deleteAttempts; i++ {
-			_, err := ctx.Client.DatabaseUsers.Delete(context.Background(), dbUser.Spec.DatabaseName, projectID, dbUser.Status.UserName)
+			_, err := ctx.Client.DatabaseUsers.Delete(ctx.Context, dbUser.Spec.DatabaseName, projectID, dbUser.Status.UserName)
 			if err == nil {
 				break
 			}
@@ -104,7 +114,7 @@ func performUpdateInAtlas(ctx *workflow.Context, k8sClient client.Client, projec
 	passwordKey := dbUser.PasswordSecretObjectKey()
 	var currentPasswordResourceVersion string
 	if passwordKey != nil {
-		if err := k8sClient.Get(context.Background(), *passwordKey, secret); err != nil {
+		if err := k8sClient.Get(ctx.Context, *passwordKey, secret); err != nil {
 			return workflow.Terminate(workflow.Internal, err.Error())
 		}
 		currentPasswordResourceVersion = secret.ResourceVersion
@@ -113,12 +123,12 @@ func performUpdateInAtlas(ctx *workflow.Context, k8sClient client.Client, projec
 	retryAfterUpdate := workflow.InProgress(workflow.DatabaseUserDeploymentAppliedChanges, "Clusters are scheduled to handle database users updates")
 
 	// Try to find the user
-	u, _, err := ctx.Client.DatabaseUsers.Get(context.Background(), dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
+	u, _, err := ctx.Client.DatabaseUsers.Get(ctx.Context, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
 	if err != nil {
 		var apiError *mongodbatlas.ErrorResponse
 		if errors.As(err, &apiError) && apiError.ErrorCode == atlas.UsernameNotFound {
 			log.Debugw("User doesn't exist. Create new user", "apiUser", apiUser)
-			if _, _, err = ctx.Client.DatabaseUsers.Create(context.Background(), project.ID(), apiUser); err != nil {
+			if _, _, err = ctx.Client.DatabaseUsers.Create(ctx.Context, project.ID(), apiUser); err != nil {
 				return workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, err.Error())
 			}
 			ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordVersion(currentPasswordResourceVersion))
@@ -133,7 +143,7 @@ func performUpdateInAtlas(ctx *workflow.Context, k8sClient client.Client, projec
 	if shouldUpdate, err := shouldUpdate(ctx.Log, u, dbUser, currentPasswordResourceVersion); err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	} else if shouldUpdate {
-		_, _, err = ctx.Client.DatabaseUsers.Update(context.Background(), project.ID(), dbUser.Spec.Username, apiUser)
+		_, _, err = ctx.Client.DatabaseUsers.Update(ctx.Context, project.ID(), dbUser.Spec.Username, apiUser)
 		if err != nil {
 			return workflow.Terminate(workflow.DatabaseUserNotUpdatedInAtlas, err.Error())
 		}
@@ -151,7 +161,7 @@ func performUpdateInAtlas(ctx *workflow.Context, k8sClient client.Client, projec
 func validateScopes(ctx *workflow.Context, projectID string, user mdbv1.AtlasDatabaseUser) error {
 	for _, s := range user.GetScopes(mdbv1.DeploymentScopeType) {
 		var apiError *mongodbatlas.ErrorResponse
-		_, _, advancedErr := ctx.Client.AdvancedClusters.Get(context.Background(), projectID, s)
+		_, _, advancedErr := ctx.Client.AdvancedClusters.Get(ctx.Context, projectID, s)
 		if errors.As(advancedErr, &apiError) && apiError.ErrorCode == atlas.ClusterNotFound {
 			return fmt.Errorf(`"scopes" field references deployment named "%s" but such deployment doesn't exist in Atlas'`, s)
 		}
@@ -160,7 +170,7 @@ func validateScopes(ctx *workflow.Context, projectID string, user mdbv1.AtlasDat
 }
 
 func checkDeploymentsHaveReachedGoalState(ctx *workflow.Context, projectID string, user mdbv1.AtlasDatabaseUser) workflow.Result {
-	allDeploymentNames, err := atlasdeployment.GetAllDeploymentNames(ctx.Client, projectID)
+	allDeploymentNames, err := atlasdeployment.GetAllDeploymentNames(ctx.Context, ctx.Client, projectID)
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
@@ -175,7 +185,7 @@ func checkDeploymentsHaveReachedGoalState(ctx *workflow.Context, projectID strin
 
 	readyDeployments := 0
 	for _, c := range deploymentsToCheck {
-		ready, err := deploymentIsReady(ctx.Client, projectID, c)
+		ready, err := deploymentIsReady(ctx.Context, ctx.Client, projectID, c)
 		if err != nil {
 			return workflow.Terminate(workflow.Internal, err.Error())
 		}
@@ -193,8 +203,8 @@ func checkDeploymentsHaveReachedGoalState(ctx *workflow.Context, projectID strin
 	return workflow.OK()
 }
 
-func deploymentIsReady(client mongodbatlas.Client, projectID, deploymentName string) (bool, error) {
-	status, _, err := client.Clusters.Status(context.Background(), projectID, deploymentName)
+func deploymentIsReady(ctx context.Context, client mongodbatlas.Client, projectID, deploymentName string) (bool, error) {
+	status, _, err := client.Clusters.Status(ctx, projectID, deploymentName)
 	if err != nil {
 		return false, err
 	}
@@ -236,34 +246,43 @@ func shouldUpdate(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUser,
 
 // TODO move to a separate utils (reuse from deployments)
 func userMatchesSpec(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUser, operatorSpec mdbv1.AtlasDatabaseUserSpec) (bool, error) {
+	d, err := userSpecDiff(atlasSpec, operatorSpec)
+	if err != nil {
+		return false, err
+	}
+	if d != "" {
+		log.Debugf("Users differs from spec: %s", d)
+	}
+
+	return d == "", nil
+}
+
+// userSpecDiff returns the difference between the user in Atlas and the user merged with the spec
+func userSpecDiff(atlasSpec *mongodbatlas.DatabaseUser, operatorSpec mdbv1.AtlasDatabaseUserSpec) (string, error) {
 	userMerged := mongodbatlas.DatabaseUser{}
 	if err := compat.JSONCopy(&userMerged, atlasSpec); err != nil {
-		return false, err
+		return "", err
 	}
 
 	if err := compat.JSONCopy(&userMerged, operatorSpec); err != nil {
-		return false, err
+		return "", err
 	}
 
 	// performing some normalization of dates
 	if atlasSpec.DeleteAfterDate != "" {
 		atlasDeleteDate, err := timeutil.ParseISO8601(atlasSpec.DeleteAfterDate)
 		if err != nil {
-			return false, err
+			return "", err
 		}
 		atlasSpec.DeleteAfterDate = timeutil.FormatISO8601(atlasDeleteDate)
 	}
 	if operatorSpec.DeleteAfterDate != "" {
 		operatorDeleteDate, err := timeutil.ParseISO8601(operatorSpec.DeleteAfterDate)
 		if err != nil {
-			return false, err
+			return "", err
 		}
 		userMerged.DeleteAfterDate = timeutil.FormatISO8601(operatorDeleteDate)
 	}
-	d := cmp.Diff(*atlasSpec, userMerged, cmpopts.EquateEmpty())
-	if d != "" {
-		log.Debugf("Users differs from spec: %s", d)
-	}
 
-	return d == "", nil
+	return cmp.Diff(*atlasSpec, userMerged, cmpopts.EquateEmpty()), nil
 }
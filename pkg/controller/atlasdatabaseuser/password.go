@@ -0,0 +1,75 @@
+package atlasdatabaseuser
+
+import (
+	"fmt"
+
+	"github.com/sethvargo/go-password/password"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// defaultPasswordLength is the length of the generated passwords unless specified otherwise
+const defaultPasswordLength = 32
+
+// ensurePasswordSecret creates the Secret with the generated password if the user asks for it and the Secret doesn't
+// exist yet. The existing Secret is never updated, so the password doesn't change once generated.
+func ensurePasswordSecret(ctx *workflow.Context, k8sClient client.Client, dbUser *mdbv1.AtlasDatabaseUser) workflow.Result {
+	if dbUser.Spec.GeneratePassword == nil {
+		return workflow.OK()
+	}
+	key := *dbUser.PasswordSecretObjectKey()
+	err := k8sClient.Get(ctx.Context, key, &corev1.Secret{})
+	if err == nil {
+		return workflow.OK()
+	}
+	if !apiErrors.IsNotFound(err) {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	generated, err := generatePassword(*dbUser.Spec.GeneratePassword)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserPasswordNotGenerated, err.Error()).WithoutRetry()
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			// The label makes the Secret visible to the Operator cache
+			Labels: map[string]string{connectionsecret.TypeLabelKey: connectionsecret.CredLabelVal},
+		},
+		Data: map[string][]byte{"password": []byte(generated)},
+	}
+	if err = controllerutil.SetControllerReference(dbUser, secret, k8sClient.Scheme()); err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+	// The Secret may be missing in the cache only, it's never overwritten anyway
+	if err = k8sClient.Create(ctx.Context, secret); err != nil && !apiErrors.IsAlreadyExists(err) {
+		return workflow.Terminate(workflow.DatabaseUserPasswordNotGenerated, err.Error())
+	}
+
+	ctx.Log.Infow("Generated the password of the database user", "name", dbUser.Spec.Username, "secret", key)
+	return workflow.OK()
+}
+
+func generatePassword(policy mdbv1.PasswordPolicy) (string, error) {
+	length := policy.Length
+	if length == 0 {
+		length = defaultPasswordLength
+	}
+	generator, err := password.NewGenerator(&password.GeneratorInput{Symbols: policy.SymbolCharset})
+	if err != nil {
+		return "", err
+	}
+	generated, err := generator.Generate(length, policy.Digits, policy.Symbols, policy.NoUpper, policy.AllowRepeat)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate the password: %w", err)
+	}
+	return generated, nil
+}
@@ -0,0 +1,119 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	t.Run("Default policy", func(t *testing.T) {
+		generated, err := generatePassword(mdbv1.PasswordPolicy{})
+		require.NoError(t, err)
+		assert.Len(t, generated, defaultPasswordLength)
+	})
+	t.Run("Custom policy", func(t *testing.T) {
+		generated, err := generatePassword(mdbv1.PasswordPolicy{Length: 20, Digits: 5, Symbols: 5, SymbolCharset: "#%", NoUpper: true, AllowRepeat: true})
+		require.NoError(t, err)
+		assert.Len(t, generated, 20)
+		assert.Equal(t, generated, strings.ToLower(generated))
+		assert.Equal(t, 5, strings.Count(generated, "#")+strings.Count(generated, "%"))
+		assert.Len(t, strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, generated), 5)
+	})
+	t.Run("Impossible policy", func(t *testing.T) {
+		_, err := generatePassword(mdbv1.PasswordPolicy{Length: 10, Symbols: 5, SymbolCharset: "#"})
+		assert.ErrorContains(t, err, "failed to generate the password")
+	})
+}
+
+func TestEnsurePasswordSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	t.Run("Password is not generated by default", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+		user := mdbv1.DefaultDBUser("ns", "theuser", "project1")
+
+		result := ensurePasswordSecret(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, user)
+		assert.True(t, result.IsOk())
+
+		secrets := corev1.SecretList{}
+		require.NoError(t, fakeClient.List(context.Background(), &secrets))
+		assert.Empty(t, secrets.Items)
+	})
+	t.Run("Password is generated in the default Secret", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+		user := mdbv1.DefaultDBUser("ns", "theuser", "project1")
+		user.UID = "user-uid"
+		user.Spec.PasswordSecret = nil
+		user.Spec.GeneratePassword = &mdbv1.PasswordPolicy{Length: 16}
+
+		result := ensurePasswordSecret(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, user)
+		require.True(t, result.IsOk())
+
+		secret := &corev1.Secret{}
+		require.NoError(t, fakeClient.Get(context.Background(), kube.ObjectKey("ns", "theuser-password"), secret))
+		assert.Len(t, secret.Data["password"], 16)
+		assert.Equal(t, connectionsecret.CredLabelVal, secret.Labels[connectionsecret.TypeLabelKey])
+		require.Len(t, secret.OwnerReferences, 1)
+		assert.Equal(t, "AtlasDatabaseUser", secret.OwnerReferences[0].Kind)
+
+		password, err := user.ReadPassword(fakeClient)
+		require.NoError(t, err)
+		assert.Equal(t, string(secret.Data["password"]), password)
+
+		result = ensurePasswordSecret(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, user)
+		require.True(t, result.IsOk())
+		password, err = user.ReadPassword(fakeClient)
+		require.NoError(t, err)
+		assert.Equal(t, string(secret.Data["password"]), password, "the generated password must not change")
+	})
+	t.Run("Existing Secret is not overwritten", func(t *testing.T) {
+		existing := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-password", Namespace: "ns"},
+			Data:       map[string][]byte{"password": []byte("provided")},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+		user := mdbv1.DefaultDBUser("ns", "theuser", "project1")
+		user.Spec.PasswordSecret = &common.ResourceRef{Name: "user-password"}
+		user.Spec.GeneratePassword = &mdbv1.PasswordPolicy{}
+
+		result := ensurePasswordSecret(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, user)
+		require.True(t, result.IsOk())
+
+		password, err := user.ReadPassword(fakeClient)
+		require.NoError(t, err)
+		assert.Equal(t, "provided", password)
+	})
+	t.Run("Invalid policy", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+		user := mdbv1.DefaultDBUser("ns", "theuser", "project1")
+		user.Spec.GeneratePassword = &mdbv1.PasswordPolicy{Length: 10, Symbols: 5, SymbolCharset: "#"}
+
+		result := ensurePasswordSecret(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, user)
+		assert.False(t, result.IsOk())
+		assert.Contains(t, result.GetMessage(), "failed to generate the password")
+	})
+}
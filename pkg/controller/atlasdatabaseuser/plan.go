@@ -0,0 +1,63 @@
+package atlasdatabaseuser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// planDatabaseUser computes the changes the reconciliation would apply to the Atlas database user without applying them.
+// An empty plan means the database user in Atlas already matches the spec.
+func planDatabaseUser(ctx *workflow.Context, k8sClient client.Client, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser) (string, error) {
+	if !dbUser.GetDeletionTimestamp().IsZero() {
+		return fmt.Sprintf("database user %q would be deleted from Atlas", dbUser.Spec.Username), nil
+	}
+
+	var changes []string
+	if dbUser.Status.UserName != "" && dbUser.Status.UserName != dbUser.Spec.Username {
+		changes = append(changes, fmt.Sprintf("database user %q would be deleted from Atlas", dbUser.Status.UserName))
+	}
+
+	u, _, err := ctx.Client.DatabaseUsers.Get(ctx.Context, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
+	if err != nil {
+		var apiError *mongodbatlas.ErrorResponse
+		if !errors.As(err, &apiError) || apiError.ErrorCode != atlas.UsernameNotFound {
+			return "", err
+		}
+		changes = append(changes, fmt.Sprintf("database user %q would be created in Atlas", dbUser.Spec.Username))
+
+		return strings.Join(changes, "\n"), nil
+	}
+
+	diff, err := userSpecDiff(u, dbUser.Spec)
+	if err != nil {
+		return "", err
+	}
+	if diff != "" {
+		changes = append(changes, fmt.Sprintf("database user %q would be updated in Atlas:\n%s", dbUser.Spec.Username, diff))
+	}
+
+	if passwordKey := dbUser.PasswordSecretObjectKey(); passwordKey != nil {
+		secret := &corev1.Secret{}
+		err = k8sClient.Get(ctx.Context, *passwordKey, secret)
+		switch {
+		case apiErrors.IsNotFound(err) && dbUser.Spec.GeneratePassword != nil:
+			changes = append(changes, fmt.Sprintf("password of database user %q would be generated in the Secret %s", dbUser.Spec.Username, passwordKey.Name))
+		case err != nil:
+			return "", err
+		case dbUser.Status.PasswordVersion != secret.ResourceVersion:
+			changes = append(changes, fmt.Sprintf("password of database user %q would be updated in Atlas", dbUser.Spec.Username))
+		}
+	}
+
+	return strings.Join(changes, "\n"), nil
+}
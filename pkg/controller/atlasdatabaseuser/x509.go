@@ -0,0 +1,57 @@
+package atlasdatabaseuser
+
+import (
+	"errors"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// defaultX509MonthsUntilExpiration is the validity of the certificates issued by Atlas unless specified otherwise
+const defaultX509MonthsUntilExpiration = 3
+
+// ensureX509Certificate issues the certificate for the MANAGED X.509 user if it doesn't have one yet, the current one is
+// invalid or needs to be renewed. The certificate is removed if the user doesn't authenticate with it anymore.
+// The result of the MANAGED user requeues the reconciliation when the certificate needs to be renewed. The current
+// certificate is read with the apiReader, so that the one issued by the previous reconciliation isn't missed.
+func ensureX509Certificate(ctx *workflow.Context, k8sClient client.Client, apiReader client.Reader, projectID string, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
+	if dbUser.Spec.X509Type != connectionsecret.X509ManagedType {
+		if err := connectionsecret.RemoveX509Certificate(k8sClient, dbUser); err != nil {
+			return workflow.Terminate(workflow.Internal, err.Error())
+		}
+		return workflow.OK()
+	}
+
+	current, err := connectionsecret.ReadX509Certificate(apiReader, dbUser)
+	switch {
+	case errors.Is(err, connectionsecret.ErrInvalidX509Certificate):
+		ctx.Log.Warnw("The X.509 certificate is invalid, issuing a new one", "error", err)
+	case err != nil:
+		return workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	case current != nil && time.Now().Before(current.RenewAt()):
+		return workflow.OK().WithRetry(time.Until(current.RenewAt()))
+	}
+
+	months := dbUser.Spec.X509MonthsUntilExpiration
+	if months == 0 {
+		months = defaultX509MonthsUntilExpiration
+	}
+	issued, _, err := ctx.Client.X509AuthDBUsers.CreateUserCertificate(ctx.Context, projectID, dbUser.Spec.Username, months)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	}
+	cert, err := connectionsecret.ParseX509Certificate([]byte(issued.Certificate))
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	}
+	if err = connectionsecret.SaveX509Certificate(k8sClient, dbUser, cert); err != nil {
+		return workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	}
+
+	ctx.Log.Infow("Issued X.509 certificate for the database user", "name", dbUser.Spec.Username, "notAfter", cert.NotAfter)
+	return workflow.OK().WithRetry(time.Until(cert.RenewAt()))
+}
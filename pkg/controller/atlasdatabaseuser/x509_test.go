@@ -0,0 +1,101 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// failingGetClient fails to read any object
+type failingGetClient struct {
+	client.Client
+}
+
+func (c failingGetClient) Get(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error {
+	return errors.New("connection refused")
+}
+
+// selfSignedCertificate returns the PEM encoded self-signed client certificate followed by its private key
+func selfSignedCertificate(t *testing.T, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "user1"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(data, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})...)
+}
+
+func TestEnsureX509Certificate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	user := mdbv1.DefaultDBUser("ns", "theuser", "project1")
+	user.Spec.X509Type = connectionsecret.X509ManagedType
+
+	t.Run("Renewal of the valid certificate is requeued", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+		now := time.Now()
+		cert, err := connectionsecret.ParseX509Certificate(selfSignedCertificate(t, now.Add(-time.Hour), now.Add(89*24*time.Hour)))
+		require.NoError(t, err)
+		require.NoError(t, connectionsecret.SaveX509Certificate(fakeClient, *user, cert))
+
+		renewIn := time.Until(cert.RenewAt())
+		result := ensureX509Certificate(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, fakeClient, "projectID", *user)
+		require.True(t, result.IsOk())
+		requeueAfter := result.ReconcileResult().RequeueAfter
+		assert.LessOrEqual(t, requeueAfter, renewIn)
+		assert.Greater(t, requeueAfter, renewIn-time.Minute)
+	})
+	t.Run("Failure to read the certificate is retried", func(t *testing.T) {
+		fakeClient := failingGetClient{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+
+		// The certificate is not reissued as the Atlas client is not set
+		result := ensureX509Certificate(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, fakeClient, "projectID", *user)
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.FailureTransient, result.Failure())
+		assert.Equal(t, reconcile.Result{RequeueAfter: workflow.DefaultRetry}, result.ReconcileResult())
+		assert.Contains(t, result.GetMessage(), "connection refused")
+	})
+	t.Run("No requeue for the users without the managed certificate", func(t *testing.T) {
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		result := ensureX509Certificate(workflow.NewContext(zap.S(), []status.Condition{}), fakeClient, fakeClient, "projectID", *mdbv1.DefaultDBUser("ns", "theuser", "project1"))
+		assert.True(t, result.IsOk())
+		assert.Equal(t, reconcile.Result{}, result.ReconcileResult())
+	})
+}
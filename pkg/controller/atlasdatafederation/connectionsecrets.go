@@ -1,7 +1,6 @@
 package atlasdatafederation
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -17,12 +16,12 @@ import (
 
 func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Context, project *mdbv1.AtlasProject, df *mdbv1.AtlasDataFederation) workflow.Result {
 	databaseUsers := mdbv1.AtlasDatabaseUserList{}
-	err := r.Client.List(context.TODO(), &databaseUsers, &client.ListOptions{})
+	err := r.Client.List(ctx.Context, &databaseUsers, &client.ListOptions{})
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
 
-	atlasDF, _, err := ctx.Client.DataFederation.Get(context.Background(), project.ID(), df.Spec.Name)
+	atlasDF, _, err := ctx.Client.DataFederation.Get(ctx.Context, project.ID(), df.Spec.Name)
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
@@ -55,21 +54,25 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(r.Client)
-		if err != nil {
+		data := connectionsecret.ConnectionData{
+			AuthDatabase:   dbUser.Spec.DatabaseName,
+			Hosts:          connectionHosts,
+			Template:       dbUser.Spec.ConnectionSecretTemplate,
+			ServiceBinding: dbUser.Spec.ServiceBinding,
+		}
+		if err = connectionsecret.ReadCredentials(r.Client, dbUser, &data); err != nil {
 			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 
 		var connURLs []string
 		for _, host := range connectionHosts {
-			connURLs = append(connURLs, fmt.Sprintf("mongodb://%s:%s@%s?ssl=true", dbUser.Spec.Username, password, host))
-		}
-
-		data := connectionsecret.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    strings.Join(connURLs, ","),
+			if data.X509 != nil {
+				connURLs = append(connURLs, fmt.Sprintf("mongodb://%s?ssl=true", host))
+				continue
+			}
+			connURLs = append(connURLs, fmt.Sprintf("mongodb://%s:%s@%s?ssl=true", data.DBUserName, data.Password, host))
 		}
+		data.ConnURL = strings.Join(connURLs, ",")
 
 		ctx.Log.Debugw("Creating a connection Secret", "data", data)
 
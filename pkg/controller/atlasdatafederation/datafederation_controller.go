@@ -20,30 +20,36 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/keylock"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 )
 
 // AtlasDataFederationReconciler reconciles an DataFederation object
 type AtlasDataFederationReconciler struct {
-	watch.ResourceWatcher
-	Client                      client.Client
-	Log                         *zap.SugaredLogger
-	Scheme                      *runtime.Scheme
-	AtlasDomain                 string
-	GlobalAPISecret             client.ObjectKey
-	GlobalPredicates            []predicate.Predicate
-	EventRecorder               record.EventRecorder
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
+	Client client.Client
+	Log    *zap.SugaredLogger
+	Scheme *runtime.Scheme
+	// Settings are the operator settings which are changed at runtime by the AtlasOperatorConfig
+	Settings                *atlasoperatorconfig.LiveSettings
+	AtlasClients            *atlas.ClientRegistry
+	GlobalAPISecret         client.ObjectKey
+	GlobalPredicates        []predicate.Predicate
+	EventRecorder           record.EventRecorder
+	MaxConcurrentReconciles int
+	ProjectLocks            *keylock.KeyLock
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatafederations,verbs=get;list;watch;create;update;patch;delete
@@ -52,7 +58,10 @@ type AtlasDataFederationReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatafederations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
-func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, req ctrl.Request) (reconcileResult ctrl.Result, _ error) {
+	contextInt, span := tracing.Start(contextInt, "AtlasDataFederation.Reconcile", tracing.ResourceAttributes(req.NamespacedName)...)
+	defer span.End()
+
 	log := r.Log.With("atlasdatafederation", req.NamespacedName)
 
 	dataFederation := &mdbv1.AtlasDataFederation{}
@@ -75,8 +84,12 @@ func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, re
 	}
 
 	ctx := customresource.MarkReconciliationStarted(r.Client, dataFederation, log)
+	ctx.Context = contextInt
 	log.Infow("-> Starting AtlasDataFederation reconciliation", "spec", dataFederation.Spec, "status", dataFederation.Status)
-	defer statushandler.Update(ctx, r.Client, r.EventRecorder, dataFederation)
+	defer func() {
+		reconcileResult = ctx.ApplyBackoff(reconcileResult)
+		statushandler.Update(ctx, r.Client, r.EventRecorder, dataFederation)
+	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(ctx, dataFederation, r.Log)
 	if !resourceVersionIsValid.IsOk() {
@@ -84,13 +97,21 @@ func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, re
 		return resourceVersionIsValid.ReconcileResult(), nil
 	}
 
+	if err := validate.DataFederation(dataFederation); err != nil {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		ctx.SetConditionFromResult(status.ValidationSucceeded, result)
+		return result.ReconcileResult(), nil
+	}
+	ctx.SetConditionTrue(status.ValidationSucceeded)
+
 	project := &mdbv1.AtlasProject{}
 	if result := r.readProjectResource(contextInt, dataFederation, project); !result.IsOk() {
 		ctx.SetConditionFromResult(status.DataFederationReadyType, result)
 		return result.ReconcileResult(), nil
 	}
+	defer r.ProjectLocks.Lock(project.ID())()
 
-	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.ConnectionSecretObjectKey())
+	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.Namespace, project.ConnectionSecretObjectKey(), project.Spec.TransportRef)
 	if err != nil {
 		result := workflow.Terminate(workflow.AtlasCredentialsNotProvided, err.Error())
 		ctx.SetConditionFromResult(status.DataFederationReadyType, result)
@@ -102,7 +123,7 @@ func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, re
 	}
 	ctx.Connection = connection
 
-	atlasClient, err := atlas.Client(r.AtlasDomain, connection, log)
+	atlasClient, err := r.AtlasClients.Client(r.Settings.AtlasDomain(), connection, log)
 	if err != nil {
 		result := workflow.Terminate(workflow.Internal, err.Error())
 		ctx.SetConditionFromResult(status.DataFederationReadyType, result)
@@ -110,7 +131,19 @@ func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, re
 	}
 	ctx.Client = atlasClient
 
-	owner, err := customresource.IsOwner(dataFederation, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(contextInt, atlasClient, project.ID(), log))
+	if customresource.ReconciliationShouldBePlanned(dataFederation) {
+		plan, err := planDataFederation(ctx, project, dataFederation)
+		if err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			ctx.SetConditionFromResult(status.ReconciliationPlannedType, result)
+			return result.ReconcileResult(), nil
+		}
+		log.Infow("-> Planned AtlasDataFederation reconciliation", "plan", plan)
+		ctx.SetPlan(plan)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	owner, err := customresource.IsOwner(dataFederation, r.Settings.ObjectDeletionProtection(), customresource.IsResourceManagedByOperator, managedByAtlas(contextInt, atlasClient, project.ID(), log))
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
 		ctx.SetConditionFromResult(status.DataFederationReadyType, result)
@@ -162,7 +195,7 @@ func (r *AtlasDataFederationReconciler) Reconcile(contextInt context.Context, re
 
 	if !dataFederation.GetDeletionTimestamp().IsZero() {
 		if customresource.HaveFinalizer(dataFederation, customresource.FinalizerLabel) {
-			if customresource.IsResourceProtected(dataFederation, r.ObjectDeletionProtection) {
+			if customresource.IsResourceProtected(dataFederation, r.Settings.ObjectDeletionProtection()) {
 				log.Info("Not removing AtlasDataFederation from Atlas as per configuration")
 			} else {
 				if err = r.deleteDataFederationFromAtlas(contextInt, &atlasClient, dataFederation, project, log); err != nil {
@@ -226,6 +259,7 @@ func (r *AtlasDataFederationReconciler) SetupWithManager(mgr ctrl.Manager) error
 		Named("AtlasDataFederation").
 		Watches(&source.Kind{Type: &mdbv1.AtlasDataFederation{}}, &watch.EventHandlerWithDelete{Controller: r}, builder.WithPredicates(r.GlobalPredicates...)).
 		For(&mdbv1.AtlasDataFederation{}, builder.WithPredicates(r.GlobalPredicates...)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Complete(r)
 }
 
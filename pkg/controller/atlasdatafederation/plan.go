@@ -0,0 +1,37 @@
+package atlasdatafederation
+
+import (
+	"fmt"
+	"net/http"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// planDataFederation computes the changes the reconciliation would apply to the Atlas Data Federation without applying them.
+// An empty plan means the Data Federation in Atlas already matches the spec.
+func planDataFederation(ctx *workflow.Context, project *mdbv1.AtlasProject, dataFederation *mdbv1.AtlasDataFederation) (string, error) {
+	name := dataFederation.Spec.Name
+	if !dataFederation.GetDeletionTimestamp().IsZero() {
+		return fmt.Sprintf("Data Federation %q would be deleted from Atlas", name), nil
+	}
+
+	atlasSpec, resp, err := ctx.Client.DataFederation.Get(ctx.Context, project.ID(), name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("Data Federation %q would be created in Atlas", name), nil
+		}
+		return "", err
+	}
+
+	dfFromAtlas, err := DataFederationFromAtlas(atlasSpec)
+	if err != nil {
+		return "", err
+	}
+
+	if areEqual, diff := dataFederationEqual(*dfFromAtlas, dataFederation.Spec, ctx.Log); !areEqual {
+		return fmt.Sprintf("Data Federation %q would be updated in Atlas:\n%s", name, diff), nil
+	}
+
+	return "", nil
+}
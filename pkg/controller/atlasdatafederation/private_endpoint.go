@@ -10,12 +10,12 @@ import (
 )
 
 func (r *AtlasDataFederationReconciler) ensurePrivateEndpoints(ctx *workflow.Context, project *mdbv1.AtlasProject, dataFederation *mdbv1.AtlasDataFederation) workflow.Result {
-	clientDF := NewClient(ctx.Client, r.AtlasDomain)
+	clientDF := NewClient(ctx.Client, r.Settings.AtlasDomain())
 
 	projectID := project.ID()
 	specPEs := dataFederation.Spec.PrivateEndpoints
 
-	atlasPEs, err := getAllDataFederationPEs(clientDF, projectID)
+	atlasPEs, err := getAllDataFederationPEs(ctx.Context, clientDF, projectID)
 	if err != nil {
 		ctx.Log.Debugw("getAllDataFederationPEs error", "err", err.Error())
 	}
@@ -34,7 +34,7 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, clientDF *DataFederati
 	ctx.Log.Debugw("Data Federation PEs to Create", "endpoints", endpointsToCreate)
 	for _, e := range endpointsToCreate {
 		endpoint := e.(mdbv1.DataFederationPE)
-		if _, _, err := clientDF.CreateOnePrivateEndpoint(context.Background(), projectID, endpoint); err != nil {
+		if _, _, err := clientDF.CreateOnePrivateEndpoint(ctx.Context, projectID, endpoint); err != nil {
 			return workflow.Terminate(workflow.Internal, err.Error())
 		}
 	}
@@ -43,7 +43,7 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, clientDF *DataFederati
 	ctx.Log.Debugw("Data Federation PEs to Delete", "endpoints", endpointsToDelete)
 	for _, item := range endpointsToDelete {
 		endpoint := item.(mdbv1.DataFederationPE)
-		if _, _, err := clientDF.DeleteOnePrivateEndpoint(context.Background(), projectID, endpoint.EndpointID); err != nil {
+		if _, _, err := clientDF.DeleteOnePrivateEndpoint(ctx.Context, projectID, endpoint.EndpointID); err != nil {
 			return workflow.Terminate(workflow.Internal, err.Error())
 		}
 	}
@@ -51,8 +51,8 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, clientDF *DataFederati
 	return workflow.OK()
 }
 
-func getAllDataFederationPEs(client *DataFederationServiceOp, projectID string) (endpoints []mdbv1.DataFederationPE, err error) {
-	endpoints, _, err = client.GetAllPrivateEndpoints(context.Background(), projectID)
+func getAllDataFederationPEs(ctx context.Context, client *DataFederationServiceOp, projectID string) (endpoints []mdbv1.DataFederationPE, err error) {
+	endpoints, _, err = client.GetAllPrivateEndpoints(ctx, projectID)
 	if endpoints == nil {
 		endpoints = make([]mdbv1.DataFederationPE, 0)
 	}
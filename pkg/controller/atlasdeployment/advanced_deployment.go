@@ -27,7 +27,7 @@ const FreeTier = "M0"
 func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) (*mongodbatlas.AdvancedCluster, workflow.Result) {
 	advancedDeploymentSpec := deployment.Spec.AdvancedDeploymentSpec
 
-	advancedDeployment, resp, err := ctx.Client.AdvancedClusters.Get(context.Background(), project.Status.ID, advancedDeploymentSpec.Name)
+	advancedDeployment, resp, err := ctx.Client.AdvancedClusters.Get(ctx.Context, project.Status.ID, advancedDeploymentSpec.Name)
 
 	if err != nil {
 		if resp == nil {
@@ -35,7 +35,7 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 		}
 
 		if resp.StatusCode != http.StatusNotFound {
-			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return advancedDeployment, workflow.TerminateWithError(workflow.DeploymentNotCreatedInAtlas, err)
 		}
 
 		advancedDeployment, err = advancedDeploymentSpec.ToAtlas()
@@ -44,9 +44,9 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 		}
 
 		ctx.Log.Infof("Advanced Deployment %s doesn't exist in Atlas - creating", advancedDeploymentSpec.Name)
-		advancedDeployment, _, err = ctx.Client.AdvancedClusters.Create(context.Background(), project.Status.ID, advancedDeployment)
+		advancedDeployment, _, err = ctx.Client.AdvancedClusters.Create(ctx.Context, project.Status.ID, advancedDeployment)
 		if err != nil {
-			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return advancedDeployment, workflow.TerminateWithError(workflow.DeploymentNotCreatedInAtlas, err)
 		}
 	}
 
@@ -112,9 +112,9 @@ func advancedDeploymentIdle(ctx *workflow.Context, project *mdbv1.AtlasProject,
 		return atlasDeploymentAsAtlas, workflow.Terminate(workflow.Internal, err.Error())
 	}
 
-	atlasDeploymentAsAtlas, _, err = ctx.Client.AdvancedClusters.Update(context.Background(), project.Status.ID, deployment.Spec.AdvancedDeploymentSpec.Name, deploymentAsAtlas)
+	atlasDeploymentAsAtlas, _, err = ctx.Client.AdvancedClusters.Update(ctx.Context, project.Status.ID, deployment.Spec.AdvancedDeploymentSpec.Name, deploymentAsAtlas)
 	if err != nil {
-		return atlasDeploymentAsAtlas, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error())
+		return atlasDeploymentAsAtlas, workflow.TerminateWithError(workflow.DeploymentNotUpdatedInAtlas, err)
 	}
 
 	return nil, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
@@ -346,10 +346,10 @@ func cleanupFieldsToCompare(atlas, operator mdbv1.AdvancedDeploymentSpec) mdbv1.
 }
 
 // GetAllDeploymentNames returns all deployment names including regular and advanced deployment.
-func GetAllDeploymentNames(client mongodbatlas.Client, projectID string) ([]string, error) {
+func GetAllDeploymentNames(ctx context.Context, client mongodbatlas.Client, projectID string) ([]string, error) {
 	var deploymentNames []string
 
-	advancedDeployments, _, err := client.AdvancedClusters.List(context.Background(), projectID, &mongodbatlas.ListOptions{})
+	advancedDeployments, _, err := client.AdvancedClusters.List(ctx, projectID, &mongodbatlas.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -396,12 +396,13 @@ func isInstanceSizeTheSame(currentDeployment *mongodbatlas.AdvancedCluster, desi
 
 func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Context, project *mdbv1.AtlasProject, name string, connectionStrings *mongodbatlas.ConnectionStrings, deploymentResource *mdbv1.AtlasDeployment) workflow.Result {
 	databaseUsers := mdbv1.AtlasDatabaseUserList{}
-	err := r.Client.List(context.TODO(), &databaseUsers, &client.ListOptions{})
+	err := r.Client.List(ctx.Context, &databaseUsers, &client.ListOptions{})
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
 
 	secrets := make([]string, 0)
+	var bindingSecrets []string
 	for i := range databaseUsers.Items {
 		dbUser := databaseUsers.Items[i]
 
@@ -427,16 +428,15 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(r.Client)
-		if err != nil {
-			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
-		}
-
 		data := connectionsecret.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    connectionStrings.Standard,
-			SrvConnURL: connectionStrings.StandardSrv,
+			ConnURL:        connectionStrings.Standard,
+			SrvConnURL:     connectionStrings.StandardSrv,
+			AuthDatabase:   dbUser.Spec.DatabaseName,
+			Template:       dbUser.Spec.ConnectionSecretTemplate,
+			ServiceBinding: dbUser.Spec.ServiceBinding,
+		}
+		if err = connectionsecret.ReadCredentials(r.Client, dbUser, &data); err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 		connectionsecret.FillPrivateConnStrings(connectionStrings, &data)
 
@@ -447,11 +447,15 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 		secrets = append(secrets, secretName)
+		if dbUser.Spec.ServiceBinding {
+			bindingSecrets = append(bindingSecrets, secretName)
+		}
 	}
 
 	if len(secrets) > 0 {
 		r.EventRecorder.Eventf(deploymentResource, "Normal", "ConnectionSecretsEnsured", "Connection Secrets were created/updated: %s", strings.Join(secrets, ", "))
 	}
+	ctx.EnsureStatusOption(status.AtlasDeploymentBindingOption(connectionsecret.BindingSecret(bindingSecrets)))
 
 	return workflow.OK()
 }
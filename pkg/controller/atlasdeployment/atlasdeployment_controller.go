@@ -20,7 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
@@ -40,9 +40,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
@@ -50,21 +50,25 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/compat"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/keylock"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 )
 
 // AtlasDeploymentReconciler reconciles an AtlasDeployment object
 type AtlasDeploymentReconciler struct {
-	watch.ResourceWatcher
-	Client                      client.Client
-	Log                         *zap.SugaredLogger
-	Scheme                      *runtime.Scheme
-	AtlasDomain                 string
-	GlobalAPISecret             client.ObjectKey
-	GlobalPredicates            []predicate.Predicate
-	EventRecorder               record.EventRecorder
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
+	Client client.Client
+	Log    *zap.SugaredLogger
+	Scheme *runtime.Scheme
+	// Settings are the operator settings which are changed at runtime by the AtlasOperatorConfig
+	Settings                *atlasoperatorconfig.LiveSettings
+	AtlasClients            *atlas.ClientRegistry
+	GlobalAPISecret         client.ObjectKey
+	GlobalPredicates        []predicate.Predicate
+	EventRecorder           record.EventRecorder
+	DriftDetectionInterval  time.Duration
+	MaxConcurrentReconciles int
+	ProjectLocks            *keylock.KeyLock
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -84,7 +88,10 @@ type AtlasDeploymentReconciler struct {
 
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
-func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.Request) (reconcileResult ctrl.Result, _ error) {
+	context, span := tracing.Start(context, "AtlasDeployment.Reconcile", tracing.ResourceAttributes(req.NamespacedName)...)
+	defer span.End()
+
 	log := r.Log.With("atlasdeployment", req.NamespacedName)
 
 	deployment := &mdbv1.AtlasDeployment{}
@@ -108,10 +115,11 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 	}
 
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, deployment, log)
+	workflowCtx.Context = context
 	log.Infow("-> Starting AtlasDeployment reconciliation", "spec", deployment.Spec, "status", deployment.Status)
 	defer func() {
+		reconcileResult = workflowCtx.ApplyBackoff(reconcileResult)
 		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, deployment)
-		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, deployment, r.Log)
@@ -132,8 +140,9 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
 		return result.ReconcileResult(), nil
 	}
+	defer r.ProjectLocks.Lock(project.ID())()
 
-	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.ConnectionSecretObjectKey())
+	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.Namespace, project.ConnectionSecretObjectKey(), project.Spec.TransportRef)
 	if err != nil {
 		result := workflow.Terminate(workflow.AtlasCredentialsNotProvided, err.Error())
 		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
@@ -141,7 +150,7 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 	}
 	workflowCtx.Connection = connection
 
-	atlasClient, err := atlas.Client(r.AtlasDomain, connection, log)
+	atlasClient, err := r.AtlasClients.Client(r.Settings.AtlasDomain(), connection, log)
 	if err != nil {
 		result := workflow.Terminate(workflow.Internal, err.Error())
 		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
@@ -150,13 +159,13 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 	workflowCtx.Client = atlasClient
 
 	// Allow users to specify M0/M2/M5 deployments without providing TENANT for Normal and Serverless deployments
-	r.verifyNonTenantCase(deployment)
+	deployment.Normalize()
 
 	// convertedDeployment is either serverless or advanced, deployment must be kept unchanged
 	// convertedDeployment is always a separate copy, to avoid changes on it to go back to k8s
 	convertedDeployment := deployment.DeepCopy()
 	if deployment.IsLegacyDeployment() {
-		if err := ConvertLegacyDeployment(&convertedDeployment.Spec); err != nil {
+		if err := mdbv1.ConvertLegacyDeployment(&convertedDeployment.Spec); err != nil {
 			result = workflow.Terminate(workflow.Internal, err.Error())
 			log.Errorw("failed to convert legacy deployment", "error", err)
 			return result.ReconcileResult(), nil
@@ -164,6 +173,18 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		convertedDeployment.Spec.DeploymentSpec = nil
 	}
 
+	if customresource.ReconciliationShouldBePlanned(deployment) {
+		plan, err := planDeployment(workflowCtx, project, convertedDeployment)
+		if err != nil {
+			result := workflow.Terminate(workflow.Internal, err.Error())
+			workflowCtx.SetConditionFromResult(status.ReconciliationPlannedType, result)
+			return result.ReconcileResult(), nil
+		}
+		log.Infow("-> Planned AtlasDeployment reconciliation", "plan", plan)
+		workflowCtx.SetPlan(plan)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
 	if result := r.checkDeploymentIsManaged(workflowCtx, context, log, project, convertedDeployment); !result.IsOk() {
 		return result.ReconcileResult(), nil
 	}
@@ -180,8 +201,14 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		return result.ReconcileResult(), nil
 	}
 
+	if result := r.handleDrift(workflowCtx, project, deployment); !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
 	handleDeployment := r.selectDeploymentHandler(convertedDeployment)
-	if result, _ := handleDeployment(context, workflowCtx, project, convertedDeployment, req); !result.IsOk() {
+	result, _ = handleDeployment(context, workflowCtx, project, convertedDeployment, req)
+	clearRevertedDrift(workflowCtx, deployment, result)
+	if !result.IsOk() {
 		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
 		return r.registerConfigAndReturn(workflowCtx, context, log, deployment, result), nil
 	}
@@ -193,7 +220,12 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		}
 	}
 
-	return r.registerConfigAndReturn(workflowCtx, context, log, deployment, workflow.OK()), nil
+	result = workflow.OK()
+	if r.DriftDetectionInterval > 0 {
+		result = result.WithRetry(r.DriftDetectionInterval)
+	}
+
+	return r.registerConfigAndReturn(workflowCtx, context, log, deployment, result), nil
 }
 
 func (r *AtlasDeploymentReconciler) registerConfigAndReturn(
@@ -215,28 +247,6 @@ func (r *AtlasDeploymentReconciler) registerConfigAndReturn(
 	return result.ReconcileResult()
 }
 
-func (r *AtlasDeploymentReconciler) verifyNonTenantCase(deployment *mdbv1.AtlasDeployment) {
-	var pSettings *mdbv1.ProviderSettingsSpec
-	var deploymentType string
-	if deployment.Spec.DeploymentSpec != nil {
-		if deployment.Spec.DeploymentSpec.ProviderSettings == nil {
-			return
-		}
-		pSettings = deployment.Spec.DeploymentSpec.ProviderSettings
-		deploymentType = "TENANT"
-	}
-
-	if deployment.Spec.ServerlessSpec != nil {
-		if deployment.Spec.ServerlessSpec.ProviderSettings == nil {
-			return
-		}
-		pSettings = deployment.Spec.ServerlessSpec.ProviderSettings
-		deploymentType = "SERVERLESS"
-	}
-
-	modifyProviderSettings(pSettings, deploymentType)
-}
-
 func (r *AtlasDeploymentReconciler) checkDeploymentIsManaged(
 	workflowCtx *workflow.Context,
 	context context.Context,
@@ -254,7 +264,7 @@ func (r *AtlasDeploymentReconciler) checkDeploymentIsManaged(
 
 	owner, err := customresource.IsOwner(
 		deployment,
-		r.ObjectDeletionProtection,
+		r.Settings.ObjectDeletionProtection(),
 		customresource.IsResourceManagedByOperator,
 		managedByAtlas(context, workflowCtx.Client, project.ID(), log),
 	)
@@ -309,7 +319,7 @@ func (r *AtlasDeploymentReconciler) handleDeletion(
 				log.Errorw("failed to cleanup deployment bindings (backups)", "error", err)
 				return true, result
 			}
-			isProtected := customresource.IsResourceProtected(deployment, r.ObjectDeletionProtection)
+			isProtected := customresource.IsResourceProtected(deployment, r.Settings.ObjectDeletionProtection())
 			if isProtected {
 				log.Info("Not removing Atlas deployment from Atlas as per configuration")
 			} else {
@@ -342,24 +352,6 @@ func (r *AtlasDeploymentReconciler) cleanupBindings(context context.Context, dep
 	return r.garbageCollectBackupResource(context, deployment.GetDeploymentName())
 }
 
-func modifyProviderSettings(pSettings *mdbv1.ProviderSettingsSpec, deploymentType string) {
-	if pSettings == nil || string(pSettings.ProviderName) == deploymentType {
-		return
-	}
-
-	switch strings.ToUpper(deploymentType) {
-	case "TENANT":
-		switch pSettings.InstanceSizeName {
-		case "M0", "M2", "M5":
-			pSettings.BackingProviderName = string(pSettings.ProviderName)
-			pSettings.ProviderName = provider.ProviderName(deploymentType)
-		}
-	case "SERVERLESS":
-		pSettings.BackingProviderName = string(pSettings.ProviderName)
-		pSettings.ProviderName = provider.ProviderName(deploymentType)
-	}
-}
-
 func (r *AtlasDeploymentReconciler) selectDeploymentHandler(deployment *mdbv1.AtlasDeployment) deploymentHandlerFunc {
 	if deployment.IsServerless() {
 		return r.handleServerlessInstance
@@ -453,8 +445,7 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecretsAndSetStatusOptions(c
 
 func (r *AtlasDeploymentReconciler) handleAdvancedOptions(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) workflow.Result {
 	deploymentName := deployment.GetDeploymentName()
-	context := context.Background()
-	atlasArgs, _, err := ctx.Client.Clusters.GetProcessArgs(context, project.Status.ID, deploymentName)
+	atlasArgs, _, err := ctx.Client.Clusters.GetProcessArgs(ctx.Context, project.Status.ID, deploymentName)
 	if err != nil {
 		return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot get process args")
 	}
@@ -469,7 +460,7 @@ func (r *AtlasDeploymentReconciler) handleAdvancedOptions(ctx *workflow.Context,
 			return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot convert process args to atlas")
 		}
 
-		args, resp, err := ctx.Client.Clusters.UpdateProcessArgs(context, project.Status.ID, deploymentName, options)
+		args, resp, err := ctx.Client.Clusters.UpdateProcessArgs(ctx.Context, project.Status.ID, deploymentName, options)
 		ctx.Log.Debugw("ProcessArgs Update", "args", args, "resp", resp.Body, "err", err)
 		if err != nil {
 			return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot update process args")
@@ -490,7 +481,7 @@ func (r *AtlasDeploymentReconciler) readProjectResource(ctx context.Context, dep
 }
 
 func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	c, err := controller.New("AtlasDeployment", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New("AtlasDeployment", mgr, controller.Options{Reconciler: r, MaxConcurrentReconciles: r.MaxConcurrentReconciles})
 	if err != nil {
 		return err
 	}
@@ -501,14 +492,24 @@ func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	indexer := mgr.GetFieldIndexer()
+	if err = indexer.IndexField(context.Background(), &mdbv1.AtlasDeployment{}, watch.DeploymentBackupScheduleIndex, watch.DeploymentBackupScheduleRefs); err != nil {
+		return err
+	}
+	if err = indexer.IndexField(context.Background(), &mdbv1.AtlasBackupSchedule{}, watch.BackupSchedulePolicyIndex, watch.BackupSchedulePolicyRefs); err != nil {
+		return err
+	}
+	deploymentsBySchedule := watch.IndexedDependants(mgr.GetClient(), &mdbv1.AtlasDeploymentList{}, watch.DeploymentBackupScheduleIndex)
+	schedulesByPolicy := watch.IndexedDependants(mgr.GetClient(), &mdbv1.AtlasBackupScheduleList{}, watch.BackupSchedulePolicyIndex)
+
 	// Watch for Backup schedules
-	err = c.Watch(&source.Kind{Type: &mdbv1.AtlasBackupSchedule{}}, watch.NewBackupScheduleHandler(r.WatchedResources))
+	err = c.Watch(&source.Kind{Type: &mdbv1.AtlasBackupSchedule{}}, watch.NewBackupScheduleHandler(deploymentsBySchedule))
 	if err != nil {
 		return err
 	}
 
-	// Watch for Backup policies
-	err = c.Watch(&source.Kind{Type: &mdbv1.AtlasBackupPolicy{}}, watch.NewBackupPolicyHandler(r.WatchedResources))
+	// Watch for Backup policies, the deployments depend on them through the backup schedules
+	err = c.Watch(&source.Kind{Type: &mdbv1.AtlasBackupPolicy{}}, watch.NewBackupPolicyHandler(deploymentsByPolicy(schedulesByPolicy, deploymentsBySchedule)))
 	if err != nil {
 		return err
 	}
@@ -516,6 +517,25 @@ func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
+func deploymentsByPolicy(schedulesByPolicy, deploymentsBySchedule watch.DependantsFunc) watch.DependantsFunc {
+	return func(ctx context.Context, policy client.ObjectKey) ([]client.ObjectKey, error) {
+		schedules, err := schedulesByPolicy(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+
+		var deployments []client.ObjectKey
+		for _, schedule := range schedules {
+			dependants, err := deploymentsBySchedule(ctx, schedule)
+			if err != nil {
+				return nil, err
+			}
+			deployments = append(deployments, dependants...)
+		}
+		return deployments, nil
+	}
+}
+
 // Delete implements a handler for the Delete event.
 func (r *AtlasDeploymentReconciler) deleteConnectionStrings(
 	context context.Context,
@@ -41,8 +41,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 )
@@ -487,7 +487,7 @@ func TestCleanupBindings(t *testing.T) {
 		require.NoError(t, r.Client.Create(context.Background(), schedule))
 
 		// test ensureBackupPolicy and cleanup
-		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule, &[]watch.WatchedObject{})
+		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule)
 		require.NoError(t, err)
 		require.NoError(t, r.cleanupBindings(context.Background(), deployment))
 
@@ -518,7 +518,7 @@ func TestCleanupBindings(t *testing.T) {
 		require.NoError(t, r.Client.Create(context.Background(), schedule))
 
 		// test cleanup
-		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule, &[]watch.WatchedObject{})
+		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule)
 		require.NoError(t, err)
 		require.NoError(t, r.cleanupBindings(context.Background(), deployment))
 
@@ -557,9 +557,9 @@ func TestCleanupBindings(t *testing.T) {
 		}
 
 		// test cleanup
-		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule, &[]watch.WatchedObject{})
+		_, err := r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule)
 		require.NoError(t, err)
-		_, err = r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule2, &[]watch.WatchedObject{})
+		_, err = r.ensureBackupPolicy(context.Background(), &workflow.Context{}, schedule2)
 		require.NoError(t, err)
 		require.NoError(t, r.cleanupBindings(context.Background(), deployment))
 
@@ -658,9 +658,9 @@ func testPrevResult() workflow.Result {
 
 func testDeploymentReconciler(log *zap.SugaredLogger, k8sclient client.Client, protected bool) *AtlasDeploymentReconciler {
 	return &AtlasDeploymentReconciler{
-		Client:                   k8sclient,
-		Log:                      log,
-		ObjectDeletionProtection: protected,
+		Client:   k8sclient,
+		Log:      log,
+		Settings: atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{ObjectDeletionProtection: protected}),
 	}
 }
 
@@ -677,7 +677,7 @@ func testProject(ns string) *v1.AtlasProject {
 }
 
 func asAdvanced(deployment *v1.AtlasDeployment) *v1.AtlasDeployment {
-	if err := ConvertLegacyDeployment(&deployment.Spec); err != nil {
+	if err := v1.ConvertLegacyDeployment(&deployment.Spec); err != nil {
 		log.Fatalf("failed to convert legacy deployment: %v", err)
 	}
 	deployment.Spec.DeploymentSpec = nil
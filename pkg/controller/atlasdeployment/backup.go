@@ -14,7 +14,6 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/compat"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
@@ -52,18 +51,12 @@ func (r *AtlasDeploymentReconciler) ensureBackupScheduleAndPolicy(
 		return fmt.Errorf("can not proceed with backup configuration. Backups are not enabled for cluster %s", deployment.GetDeploymentName())
 	}
 
-	resourcesToWatch := []watch.WatchedObject{}
-	defer func() {
-		service.AddResourcesToWatch(resourcesToWatch...)
-		r.Log.Debugf("watched backup schedule and policy resources: %v\r\n", r.WatchedResources)
-	}()
-
-	bSchedule, err := r.ensureBackupSchedule(ctx, service, deployment, &resourcesToWatch)
+	bSchedule, err := r.ensureBackupSchedule(ctx, service, deployment)
 	if err != nil {
 		return err
 	}
 
-	bPolicy, err := r.ensureBackupPolicy(ctx, service, bSchedule, &resourcesToWatch)
+	bPolicy, err := r.ensureBackupPolicy(ctx, service, bSchedule)
 	if err != nil {
 		return err
 	}
@@ -111,7 +104,6 @@ func (r *AtlasDeploymentReconciler) ensureBackupSchedule(
 	ctx context.Context,
 	service *workflow.Context,
 	deployment *mdbv1.AtlasDeployment,
-	resourcesToWatch *[]watch.WatchedObject,
 ) (*mdbv1.AtlasBackupSchedule, error) {
 	backupScheduleRef := deployment.Spec.BackupScheduleRef.GetObject(deployment.Namespace)
 	bSchedule := &mdbv1.AtlasBackupSchedule{}
@@ -157,8 +149,6 @@ func (r *AtlasDeploymentReconciler) ensureBackupSchedule(
 		return nil, err
 	}
 
-	*resourcesToWatch = append(*resourcesToWatch, watch.WatchedObject{ResourceKind: bSchedule.Kind, Resource: *backupScheduleRef})
-
 	return bSchedule, nil
 }
 
@@ -166,7 +156,6 @@ func (r *AtlasDeploymentReconciler) ensureBackupPolicy(
 	ctx context.Context,
 	service *workflow.Context,
 	bSchedule *mdbv1.AtlasBackupSchedule,
-	resourcesToWatch *[]watch.WatchedObject,
 ) (*mdbv1.AtlasBackupPolicy, error) {
 	bPolicyRef := *bSchedule.Spec.PolicyRef.GetObject(bSchedule.Namespace)
 	bPolicy := &mdbv1.AtlasBackupPolicy{}
@@ -209,8 +198,6 @@ func (r *AtlasDeploymentReconciler) ensureBackupPolicy(
 		return nil, err
 	}
 
-	*resourcesToWatch = append(*resourcesToWatch, watch.WatchedObject{ResourceKind: bPolicy.Kind, Resource: bPolicyRef})
-
 	return bPolicy, nil
 }
 
@@ -235,7 +222,7 @@ func (r *AtlasDeploymentReconciler) updateBackupScheduleAndPolicy(
 
 	r.Log.Debugf("successfully received backup configuration: %v", currentSchedule)
 
-	owner, err := customresource.IsOwner(bSchedule, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, backupScheduleManagedByAtlas(ctx, service.Client, projectID, clusterName, bPolicy))
+	owner, err := customresource.IsOwner(bSchedule, r.Settings.ObjectDeletionProtection(), customresource.IsResourceManagedByOperator, backupScheduleManagedByAtlas(ctx, service.Client, projectID, clusterName, bPolicy))
 	if err != nil {
 		return err
 	}
@@ -12,7 +12,7 @@ import (
 )
 
 func EnsureCustomZoneMapping(service *workflow.Context, groupID string, customZoneMappings []mdbv1.CustomZoneMapping, deploymentName string) workflow.Result {
-	result := syncCustomZoneMapping(context.Background(), service, groupID, deploymentName, customZoneMappings)
+	result := syncCustomZoneMapping(service.Context, service, groupID, deploymentName, customZoneMappings)
 	if !result.IsOk() {
 		service.SetConditionFromResult(status.CustomZoneMappingReadyType, result)
 		return result
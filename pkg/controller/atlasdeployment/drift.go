@@ -0,0 +1,159 @@
+package atlasdeployment
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// handleDrift reports the changes done to the deployment in Atlas outside the operator.
+// The reconciliation stops when the drift must not be reverted.
+func (r *AtlasDeploymentReconciler) handleDrift(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) workflow.Result {
+	fields, err := detectDrift(ctx, project, deployment)
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to detect drift: %s", err))
+	}
+
+	if len(fields) == 0 {
+		ctx.UnsetCondition(status.DriftedType)
+		return workflow.OK()
+	}
+
+	msg := fmt.Sprintf("deployment was changed in Atlas outside the operator: %s", strings.Join(fields, ", "))
+	ctx.Log.Warnw("Drift detected", "fields", fields)
+	ctx.SetDrift(msg)
+
+	if customresource.DriftShouldBeReverted(deployment) {
+		// the reconciliation goes on, so the Drifted condition won't be the last one to be reported as an event
+		r.EventRecorder.Event(deployment, corev1.EventTypeWarning, string(workflow.AtlasDriftDetected), msg)
+		return workflow.OK()
+	}
+
+	result := workflow.Terminate(workflow.AtlasDriftDetected, msg).WithoutRetry()
+	if r.DriftDetectionInterval > 0 {
+		result = result.WithRetry(r.DriftDetectionInterval)
+	}
+
+	return result
+}
+
+// clearRevertedDrift removes the Drifted condition once the spec was applied to Atlas over the drift, the result is
+// in progress while Atlas applies the reverting update.
+func clearRevertedDrift(ctx *workflow.Context, deployment *mdbv1.AtlasDeployment, result workflow.Result) {
+	if !customresource.DriftShouldBeReverted(deployment) {
+		return
+	}
+	if result.IsOk() || result.IsInProgress() {
+		ctx.UnsetCondition(status.DriftedType)
+	}
+}
+
+// detectDrift returns the fields of the deployment changed in Atlas since the operator last applied the spec.
+// The drift is only detected for deployments the operator successfully reconciled before and that are idle in Atlas.
+func detectDrift(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) ([]string, error) {
+	if !isDeploymentReady(deployment) {
+		return nil, nil
+	}
+
+	lastApplied := &mdbv1.AtlasDeployment{}
+	ok, err := customresource.ReadLastAppliedSpec(deployment, &lastApplied.Spec)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	lastApplied.Normalize()
+	if lastApplied.IsLegacyDeployment() {
+		if err = mdbv1.ConvertLegacyDeployment(&lastApplied.Spec); err != nil {
+			return nil, err
+		}
+		lastApplied.Spec.DeploymentSpec = nil
+	}
+
+	if lastApplied.IsServerless() {
+		return detectServerlessDrift(ctx, project, lastApplied.Spec.ServerlessSpec)
+	}
+
+	if lastApplied.Spec.AdvancedDeploymentSpec == nil {
+		return nil, nil
+	}
+
+	return detectAdvancedDeploymentDrift(ctx, project, lastApplied.Spec.AdvancedDeploymentSpec)
+}
+
+func detectAdvancedDeploymentDrift(ctx *workflow.Context, project *mdbv1.AtlasProject, lastApplied *mdbv1.AdvancedDeploymentSpec) ([]string, error) {
+	atlasDeployment, resp, err := ctx.Client.AdvancedClusters.Get(ctx.Context, project.ID(), lastApplied.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if atlasDeployment.StateName != status.StateIDLE {
+		return nil, nil
+	}
+
+	if err = handleAutoscaling(ctx, lastApplied, atlasDeployment); err != nil {
+		return nil, err
+	}
+
+	specDeployment, atlasSpec, err := MergedAdvancedDeployment(*atlasDeployment, *lastApplied)
+	if err != nil {
+		return nil, err
+	}
+	atlasSpec = cleanupFieldsToCompare(atlasSpec, specDeployment)
+
+	return customresource.DriftedFields(specDeployment, atlasSpec, cmpopts.EquateEmpty(), cmpopts.SortSlices(mdbv1.LessAD)), nil
+}
+
+func detectServerlessDrift(ctx *workflow.Context, project *mdbv1.AtlasProject, lastApplied *mdbv1.ServerlessSpec) ([]string, error) {
+	atlasDeployment, resp, err := ctx.Client.ServerlessInstances.Get(ctx.Context, project.ID(), lastApplied.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if atlasDeployment.StateName != status.StateIDLE {
+		return nil, nil
+	}
+
+	lastAppliedAsAtlas, err := lastApplied.ToAtlas()
+	if err != nil {
+		return nil, err
+	}
+
+	// only the tags of a serverless instance are synchronized with Atlas
+	var lastAppliedTags, atlasTags []*mongodbatlas.Tag
+	if lastAppliedAsAtlas.Tags != nil {
+		lastAppliedTags = *lastAppliedAsAtlas.Tags
+	}
+	if atlasDeployment.Tags != nil {
+		atlasTags = *atlasDeployment.Tags
+	}
+	if isTagsEqual(lastAppliedTags, atlasTags) {
+		return nil, nil
+	}
+
+	return []string{"Tags"}, nil
+}
+
+// isDeploymentReady checks the DeploymentReady condition, as the Ready one is reset when the reconciliation starts
+func isDeploymentReady(deployment *mdbv1.AtlasDeployment) bool {
+	for _, condition := range deployment.Status.GetConditions() {
+		if condition.Type == status.DeploymentReadyType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
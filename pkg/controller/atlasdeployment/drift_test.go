@@ -0,0 +1,152 @@
+package atlasdeployment
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	atlas_mock "github.com/mongodb/mongodb-atlas-kubernetes/internal/mocks/atlas"
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+func TestHandleDrift(t *testing.T) {
+	testCases := []struct {
+		title          string
+		ready          bool
+		driftPolicy    string
+		inAtlas        *mongodbatlas.AdvancedCluster
+		expectedOK     bool
+		expectedDrift  bool
+		expectedEvents int
+	}{
+		{
+			title:      "deployment not ready yet is not checked",
+			ready:      false,
+			inAtlas:    differentAdvancedDeployment(fakeDomain),
+			expectedOK: true,
+		},
+		{
+			title:      "deployment same in Atlas has no drift",
+			ready:      true,
+			inAtlas:    sameAdvancedDeployment(fakeDomain),
+			expectedOK: true,
+		},
+		{
+			title:          "deployment changed in Atlas is reported and reverted",
+			ready:          true,
+			inAtlas:        differentAdvancedDeployment(fakeDomain),
+			expectedOK:     true,
+			expectedDrift:  true,
+			expectedEvents: 1,
+		},
+		{
+			title:         "deployment changed in Atlas is only reported with the report policy",
+			ready:         true,
+			driftPolicy:   customresource.DriftPolicyReport,
+			inAtlas:       differentAdvancedDeployment(fakeDomain),
+			expectedOK:    false,
+			expectedDrift: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			tc.inAtlas.StateName = status.StateIDLE
+			atlasClient := mongodbatlas.Client{
+				AdvancedClusters: &atlas_mock.AdvancedClustersClientMock{
+					GetFunc: func(groupID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+						return tc.inAtlas, nil, nil
+					},
+				},
+			}
+			project := testProject(fakeNamespace)
+			deployment := asAdvanced(v1.NewDeployment(project.Namespace, fakeDeployment, fakeDeployment))
+			lastApplied, err := json.Marshal(deployment.Spec)
+			require.NoError(t, err)
+			customresource.SetAnnotation(deployment, customresource.AnnotationLastAppliedConfiguration, string(lastApplied))
+			if tc.driftPolicy != "" {
+				customresource.SetAnnotation(deployment, customresource.DriftPolicyAnnotation, tc.driftPolicy)
+			}
+			if tc.ready {
+				deployment.Status.Conditions = []status.Condition{{Type: status.DeploymentReadyType, Status: corev1.ConditionTrue}}
+			}
+			te := newTestDeploymentEnv(t, false, atlasClient, testK8sClient(), project, deployment)
+			recorder := record.NewFakeRecorder(10)
+			te.reconciler.EventRecorder = recorder
+			te.reconciler.DriftDetectionInterval = time.Minute
+
+			result := te.reconciler.handleDrift(te.workflowCtx, te.project, te.deployment)
+
+			assert.Equal(t, tc.expectedOK, result.IsOk())
+			if !tc.expectedOK {
+				assert.Equal(t, time.Minute, result.ReconcileResult().RequeueAfter)
+			}
+			assert.Len(t, recorder.Events, tc.expectedEvents)
+
+			drifted := false
+			for _, condition := range te.workflowCtx.Conditions() {
+				if condition.Type == status.DriftedType {
+					drifted = true
+					assert.Contains(t, condition.Message, "ReplicationSpecs[0].RegionConfigs[0]")
+				}
+			}
+			assert.Equal(t, tc.expectedDrift, drifted)
+		})
+	}
+}
+
+func TestClearRevertedDrift(t *testing.T) {
+	testCases := []struct {
+		title         string
+		driftPolicy   string
+		result        workflow.Result
+		expectedDrift bool
+	}{
+		{
+			title:  "drift is cleared once reverted",
+			result: workflow.OK(),
+		},
+		{
+			title:  "drift is cleared while the revert is applied in Atlas",
+			result: workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating"),
+		},
+		{
+			title:         "drift is kept if the revert failed",
+			result:        workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, "failed"),
+			expectedDrift: true,
+		},
+		{
+			title:         "drift is kept with the report policy",
+			driftPolicy:   customresource.DriftPolicyReport,
+			result:        workflow.OK(),
+			expectedDrift: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			deployment := v1.NewDeployment(fakeNamespace, fakeDeployment, fakeDeployment)
+			if tc.driftPolicy != "" {
+				customresource.SetAnnotation(deployment, customresource.DriftPolicyAnnotation, tc.driftPolicy)
+			}
+			ctx := workflow.NewContext(zap.S(), []status.Condition{})
+			ctx.SetDrift("deployment was changed in Atlas outside the operator")
+
+			clearRevertedDrift(ctx, deployment, tc.result)
+
+			drifted := false
+			for _, condition := range ctx.Conditions() {
+				drifted = drifted || condition.Type == status.DriftedType
+			}
+			assert.Equal(t, tc.expectedDrift, drifted)
+		})
+	}
+}
@@ -17,7 +17,7 @@ func EnsureManagedNamespaces(service *workflow.Context, groupID string, clusterT
 		return workflow.Terminate(workflow.ManagedNamespacesReady, "Managed namespace is only supported by GeoSharded clusters")
 	}
 
-	result := syncManagedNamespaces(context.Background(), service, groupID, deploymentName, managedNamespace)
+	result := syncManagedNamespaces(service.Context, service, groupID, deploymentName, managedNamespace)
 	if !result.IsOk() {
 		service.SetConditionFromResult(status.ManagedNamespacesReadyType, result)
 		return result
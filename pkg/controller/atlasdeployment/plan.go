@@ -0,0 +1,83 @@
+package atlasdeployment
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// planDeployment computes the changes the reconciliation would apply to the Atlas deployment without applying them.
+// An empty plan means the deployment in Atlas already matches the spec.
+func planDeployment(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) (string, error) {
+	name := deployment.GetDeploymentName()
+	if !deployment.GetDeletionTimestamp().IsZero() {
+		return fmt.Sprintf("deployment %q would be deleted from Atlas", name), nil
+	}
+
+	if deployment.IsServerless() {
+		return planServerlessInstance(ctx, project, deployment)
+	}
+
+	return planAdvancedDeployment(ctx, project, deployment)
+}
+
+func planAdvancedDeployment(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) (string, error) {
+	desired := deployment.Spec.AdvancedDeploymentSpec.DeepCopy()
+
+	atlasDeployment, resp, err := ctx.Client.AdvancedClusters.Get(ctx.Context, project.Status.ID, desired.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("deployment %q would be created in Atlas", desired.Name), nil
+		}
+		return "", err
+	}
+
+	if err = handleAutoscaling(ctx, desired, atlasDeployment); err != nil {
+		return "", err
+	}
+
+	specDeployment, atlasDeploymentSpec, err := MergedAdvancedDeployment(*atlasDeployment, *desired)
+	if err != nil {
+		return "", err
+	}
+
+	if areEqual, diff := AdvancedDeploymentsEqual(ctx.Log, specDeployment, atlasDeploymentSpec); !areEqual {
+		return fmt.Sprintf("deployment %q would be updated in Atlas:\n%s", desired.Name, diff), nil
+	}
+
+	return "", nil
+}
+
+func planServerlessInstance(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) (string, error) {
+	serverlessSpec := deployment.Spec.ServerlessSpec
+
+	atlasDeployment, resp, err := ctx.Client.ServerlessInstances.Get(ctx.Context, project.Status.ID, serverlessSpec.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("serverless instance %q would be created in Atlas", serverlessSpec.Name), nil
+		}
+		return "", err
+	}
+
+	desired, err := serverlessSpec.ToAtlas()
+	if err != nil {
+		return "", err
+	}
+
+	var desiredTags, atlasTags []*mongodbatlas.Tag
+	if desired.Tags != nil {
+		desiredTags = *desired.Tags
+	}
+	if atlasDeployment.Tags != nil {
+		atlasTags = *atlasDeployment.Tags
+	}
+	if !isTagsEqual(atlasTags, desiredTags) {
+		return fmt.Sprintf("tags of serverless instance %q would be updated in Atlas", serverlessSpec.Name), nil
+	}
+
+	return "", nil
+}
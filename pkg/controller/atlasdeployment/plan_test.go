@@ -0,0 +1,100 @@
+package atlasdeployment
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	atlas_mock "github.com/mongodb/mongodb-atlas-kubernetes/internal/mocks/atlas"
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+)
+
+func TestPlanDeployment(t *testing.T) {
+	notFound := &mongodbatlas.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	testCases := []struct {
+		title        string
+		serverless   bool
+		deleted      bool
+		inAtlas      *mongodbatlas.AdvancedCluster
+		serverlessIn *mongodbatlas.Cluster
+		expectedPlan string
+	}{
+		{
+			title:        "advanced deployment missing in Atlas would be created",
+			expectedPlan: "deployment \"fake-cluster\" would be created in Atlas",
+		},
+		{
+			title:        "advanced deployment same in Atlas has nothing to plan",
+			inAtlas:      sameAdvancedDeployment(fakeDomain),
+			expectedPlan: "",
+		},
+		{
+			title:        "advanced deployment different in Atlas would be updated",
+			inAtlas:      differentAdvancedDeployment(fakeDomain),
+			expectedPlan: "deployment \"fake-cluster\" would be updated in Atlas:\n",
+		},
+		{
+			title:        "deleted deployment would be deleted",
+			deleted:      true,
+			expectedPlan: "deployment \"fake-cluster\" would be deleted from Atlas",
+		},
+		{
+			title:        "serverless instance missing in Atlas would be created",
+			serverless:   true,
+			expectedPlan: "serverless instance \"test-serverless-instance\" would be created in Atlas",
+		},
+		{
+			title:        "serverless instance same in Atlas has nothing to plan",
+			serverless:   true,
+			serverlessIn: sameServerlessDeployment(fakeDomain),
+			expectedPlan: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			atlasClient := mongodbatlas.Client{
+				AdvancedClusters: &atlas_mock.AdvancedClustersClientMock{
+					GetFunc: func(groupID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+						if tc.inAtlas == nil {
+							return nil, notFound, &mongodbatlas.ErrorResponse{ErrorCode: atlas.ClusterNotFound}
+						}
+						return tc.inAtlas, nil, nil
+					},
+				},
+				ServerlessInstances: &atlas_mock.ServerlessInstancesClientMock{
+					GetFunc: func(groupID string, name string) (*mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+						if tc.serverlessIn == nil {
+							return nil, notFound, &mongodbatlas.ErrorResponse{ErrorCode: atlas.ServerlessInstanceNotFound}
+						}
+						return tc.serverlessIn, nil, nil
+					},
+				},
+			}
+			project := testProject(fakeNamespace)
+			deployment := asAdvanced(v1.NewDeployment(project.Namespace, fakeDeployment, fakeDeployment))
+			if tc.serverless {
+				deployment = v1.NewDefaultAWSServerlessInstance(project.Namespace, project.Name)
+			}
+			if tc.deleted {
+				now := metav1.Now()
+				deployment.DeletionTimestamp = &now
+			}
+			te := newTestDeploymentEnv(t, false, atlasClient, testK8sClient(), project, deployment)
+
+			plan, err := planDeployment(te.workflowCtx, te.project, te.deployment)
+
+			require.NoError(t, err)
+			if tc.expectedPlan == "" {
+				assert.Empty(t, plan)
+			} else {
+				assert.Contains(t, plan, tc.expectedPlan)
+			}
+		})
+	}
+}
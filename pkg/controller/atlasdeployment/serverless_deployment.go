@@ -18,14 +18,14 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(ctx context.Co
 		return nil, workflow.Terminate(workflow.ServerlessPrivateEndpointReady, "deployment spec is empty")
 	}
 	serverlessSpec := deployment.Spec.ServerlessSpec
-	atlasDeployment, resp, err := workflowCtx.Client.ServerlessInstances.Get(context.Background(), project.Status.ID, serverlessSpec.Name)
+	atlasDeployment, resp, err := workflowCtx.Client.ServerlessInstances.Get(workflowCtx.Context, project.Status.ID, serverlessSpec.Name)
 	if err != nil {
 		if resp == nil {
 			return atlasDeployment, workflow.Terminate(workflow.Internal, err.Error())
 		}
 
 		if resp.StatusCode != http.StatusNotFound {
-			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return atlasDeployment, workflow.TerminateWithError(workflow.DeploymentNotCreatedInAtlas, err)
 		}
 
 		atlasDeployment, err = serverlessSpec.ToAtlas()
@@ -33,7 +33,7 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(ctx context.Co
 			return atlasDeployment, workflow.Terminate(workflow.Internal, err.Error())
 		}
 		workflowCtx.Log.Infof("Serverless Instance %s doesn't exist in Atlas - creating", serverlessSpec.Name)
-		atlasDeployment, _, err = workflowCtx.Client.ServerlessInstances.Create(context.Background(), project.Status.ID, &mongodbatlas.ServerlessCreateRequestParams{
+		atlasDeployment, _, err = workflowCtx.Client.ServerlessInstances.Create(workflowCtx.Context, project.Status.ID, &mongodbatlas.ServerlessCreateRequestParams{
 			Name: serverlessSpec.Name,
 			ProviderSettings: &mongodbatlas.ServerlessProviderSettings{
 				BackingProviderName: serverlessSpec.ProviderSettings.BackingProviderName,
@@ -43,7 +43,7 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(ctx context.Co
 			Tag: atlasDeployment.Tags,
 		})
 		if err != nil {
-			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return atlasDeployment, workflow.TerminateWithError(workflow.DeploymentNotCreatedInAtlas, err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(ctx context.Co
 			convertedDeployment.Tags = &[]*mongodbatlas.Tag{}
 		}
 		if !isTagsEqual(*(atlasDeployment.Tags), *(convertedDeployment.Tags)) {
-			atlasDeployment, _, err = workflowCtx.Client.ServerlessInstances.Update(context.Background(), project.Status.ID, serverlessSpec.Name, &mongodbatlas.ServerlessUpdateRequestParams{
+			atlasDeployment, _, err = workflowCtx.Client.ServerlessInstances.Update(workflowCtx.Context, project.Status.ID, serverlessSpec.Name, &mongodbatlas.ServerlessUpdateRequestParams{
 				Tag: convertedDeployment.Tags,
 				ServerlessBackupOptions: &mongodbatlas.ServerlessBackupOptions{
 					ServerlessContinuousBackupEnabled: &serverlessSpec.BackupOptions.ServerlessContinuousBackupEnabled,
@@ -65,11 +65,11 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(ctx context.Co
 				TerminationProtectionEnabled: &serverlessSpec.TerminationProtectionEnabled,
 			})
 			if err != nil {
-				return atlasDeployment, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error())
+				return atlasDeployment, workflow.TerminateWithError(workflow.DeploymentNotUpdatedInAtlas, err)
 			}
 			return atlasDeployment, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
 		}
-		result := ensureServerlessPrivateEndpoints(ctx, workflowCtx, project.ID(), deployment, atlasDeployment.Name, r.SubObjectDeletionProtection)
+		result := ensureServerlessPrivateEndpoints(ctx, workflowCtx, project.ID(), deployment, atlasDeployment.Name, r.Settings.SubObjectDeletionProtection())
 		return atlasDeployment, result
 
 	case status.StateCREATING:
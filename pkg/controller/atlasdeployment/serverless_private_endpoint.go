@@ -65,7 +65,7 @@ func ensureServerlessPrivateEndpoints(ctx context.Context, service *workflow.Con
 		}
 	}
 
-	result := syncServerlessPrivateEndpoints(context.Background(), service, groupID, deploymentName, providerName, deploymentSpec.PrivateEndpoints)
+	result := syncServerlessPrivateEndpoints(service.Context, service, groupID, deploymentName, providerName, deploymentSpec.PrivateEndpoints)
 	if !result.IsOk() {
 		service.SetConditionFromResult(status.ServerlessPrivateEndpointReadyType, result)
 		return result
@@ -0,0 +1,133 @@
+package atlasoperatorconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// AtlasOperatorConfigReconciler applies the AtlasOperatorConfig used by the operator. The log level, the retry
+// intervals, the deletion protection and the Atlas domain are changed at runtime, the watched namespaces and the sync
+// period are read once the operator starts (see Load).
+type AtlasOperatorConfigReconciler struct {
+	Client        client.Client
+	Log           *zap.SugaredLogger
+	EventRecorder record.EventRecorder
+	// Name is the name of the AtlasOperatorConfig used by the operator, the others are ignored
+	Name string
+	// Defaults are the settings passed to the operator with the flags and the environment variables
+	Defaults Settings
+	// Startup are the settings the operator started with
+	Startup Settings
+	// LogLevel is the level of the operator logger
+	LogLevel zap.AtomicLevel
+	// Live are the settings read by the other reconcilers
+	Live *LiveSettings
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasoperatorconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasoperatorconfigs/status,verbs=get;update;patch
+
+func (r *AtlasOperatorConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (reconcileResult ctrl.Result, _ error) {
+	log := r.Log.With("atlasoperatorconfig", req.Name)
+
+	operatorConfig := &mdbv1.AtlasOperatorConfig{}
+	if err := r.Client.Get(ctx, req.NamespacedName, operatorConfig); err != nil {
+		if apiErrors.IsNotFound(err) {
+			log.Infof("AtlasOperatorConfig %s doesn't exist, restoring the default settings", req.Name)
+			r.apply(r.Defaults)
+			return workflow.OK().ReconcileResult(), nil
+		}
+		log.Errorf("Failed to query object %s: %s", req.Name, err)
+		return workflow.TerminateSilently().ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, operatorConfig, log)
+	workflowCtx.Context = ctx
+	log.Infow("-> Starting AtlasOperatorConfig reconciliation", "spec", operatorConfig.Spec)
+	defer func() {
+		reconcileResult = workflowCtx.ApplyBackoff(reconcileResult)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, operatorConfig)
+	}()
+
+	settings, err := r.Defaults.WithConfig(operatorConfig.Spec)
+	if err != nil {
+		result := workflow.Terminate(workflow.OperatorConfigInvalid, err.Error())
+		workflowCtx.SetConditionFromResult(status.ReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	r.apply(settings)
+	workflowCtx.EnsureStatusOption(status.AtlasOperatorConfigSetEffective(r.Startup.WithRuntimeSettings(settings).toStatus()))
+
+	if pending := r.Startup.RestartRequired(settings); len(pending) > 0 {
+		workflowCtx.SetConditionFromResult(status.ReadyType, workflow.InProgress(
+			workflow.OperatorRestartRequired,
+			fmt.Sprintf("the changes of %s are applied once the operator restarts", strings.Join(pending, ", ")),
+		))
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	workflowCtx.SetConditionTrue(status.ReadyType)
+	return workflow.OK().ReconcileResult(), nil
+}
+
+// apply changes the settings which can be changed at runtime
+func (r *AtlasOperatorConfigReconciler) apply(settings Settings) {
+	r.LogLevel.SetLevel(settings.LogLevel)
+	workflow.ConfigureRetry(settings.Retry)
+	r.Live.Store(settings)
+}
+
+func (r *AtlasOperatorConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasOperatorConfig").
+		For(&mdbv1.AtlasOperatorConfig{}, builder.WithPredicates(
+			watch.CommonPredicates(),
+			predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetName() == r.Name
+			}),
+		)).
+		Complete(r)
+}
+
+// Load returns the settings overridden by the AtlasOperatorConfig with the name. The settings are returned unchanged
+// if the configuration doesn't exist or is invalid. The configuration should be watched unless it can't be read,
+// e.g. if its CRD isn't installed or the operator isn't allowed to read the cluster-scoped resources.
+func Load(ctx context.Context, reader client.Reader, name string, settings Settings, log *zap.SugaredLogger) (Settings, bool) {
+	operatorConfig := &mdbv1.AtlasOperatorConfig{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: name}, operatorConfig); err != nil {
+		if apiErrors.IsNotFound(err) {
+			log.Infof("AtlasOperatorConfig %s doesn't exist, using the default settings", name)
+			return settings, true
+		}
+		if meta.IsNoMatchError(err) || apiErrors.IsForbidden(err) {
+			log.Infof("AtlasOperatorConfig %s can't be read, using the default settings: %s", name, err)
+			return settings, false
+		}
+		log.Errorf("Failed to read AtlasOperatorConfig %s, using the default settings: %s", name, err)
+		return settings, true
+	}
+
+	result, err := settings.WithConfig(operatorConfig.Spec)
+	if err != nil {
+		log.Errorf("AtlasOperatorConfig %s is invalid, using the default settings: %s", name, err)
+		return settings, true
+	}
+	return result, true
+}
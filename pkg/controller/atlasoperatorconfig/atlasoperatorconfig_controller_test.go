@@ -0,0 +1,159 @@
+package atlasoperatorconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
+)
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+	defer workflow.ConfigureRetry(workflow.DefaultRetryIntervals())
+
+	newReconciler := func(objects ...client.Object) *AtlasOperatorConfigReconciler {
+		return &AtlasOperatorConfigReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			Log:           zap.S(),
+			EventRecorder: record.NewFakeRecorder(10),
+			Name:          "operator",
+			Defaults:      defaultSettings(),
+			Startup:       defaultSettings(),
+			LogLevel:      zap.NewAtomicLevelAt(zapcore.InfoLevel),
+			Live:          NewLiveSettings(defaultSettings()),
+		}
+	}
+	reconcile := func(t *testing.T, r *AtlasOperatorConfigReconciler) *mdbv1.AtlasOperatorConfig {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "operator"}})
+		require.NoError(t, err)
+		operatorConfig := &mdbv1.AtlasOperatorConfig{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Name: "operator"}, operatorConfig))
+		return operatorConfig
+	}
+	operatorConfig := func(spec mdbv1.AtlasOperatorConfigSpec) *mdbv1.AtlasOperatorConfig {
+		return &mdbv1.AtlasOperatorConfig{ObjectMeta: metav1.ObjectMeta{Name: "operator"}, Spec: spec}
+	}
+
+	t.Run("Runtime settings are applied", func(t *testing.T) {
+		r := newReconciler(operatorConfig(mdbv1.AtlasOperatorConfigSpec{
+			LogLevel:                 "debug",
+			Retry:                    &mdbv1.RetryIntervals{Transient: &metav1.Duration{Duration: time.Second}},
+			ObjectDeletionProtection: toptr.MakePtr(true),
+			AtlasDomain:              "https://cloud-qa.mongodb.com/",
+		}))
+		result := reconcile(t, r)
+
+		assert.Equal(t, zapcore.DebugLevel, r.LogLevel.Level())
+		assert.Equal(t, time.Second, workflow.CurrentRetryIntervals().Transient)
+		assert.True(t, r.Live.ObjectDeletionProtection())
+		assert.Equal(t, "https://cloud-qa.mongodb.com/", r.Live.AtlasDomain())
+		assert.Equal(t, corev1.ConditionTrue, readyStatus(result))
+		require.NotNil(t, result.Status.Effective)
+		assert.Equal(t, "debug", result.Status.Effective.LogLevel)
+		assert.Equal(t, time.Second, result.Status.Effective.Retry.Transient.Duration)
+		assert.True(t, result.Status.Effective.ObjectDeletionProtection)
+	})
+
+	t.Run("Startup settings require the restart", func(t *testing.T) {
+		r := newReconciler(operatorConfig(mdbv1.AtlasOperatorConfigSpec{
+			SyncPeriod:        &metav1.Duration{Duration: time.Hour},
+			WatchedNamespaces: []string{"ns"},
+		}))
+		result := reconcile(t, r)
+
+		assert.Equal(t, corev1.ConditionFalse, readyStatus(result))
+		assert.Equal(t, string(workflow.OperatorRestartRequired), result.Status.Conditions[0].Reason)
+		assert.Contains(t, result.Status.Conditions[0].Message, "syncPeriod, watchedNamespaces")
+		// the status reports the settings the operator runs with
+		assert.Equal(t, 3*time.Hour, result.Status.Effective.SyncPeriod.Duration)
+		assert.Empty(t, result.Status.Effective.WatchedNamespaces)
+	})
+
+	t.Run("Invalid config", func(t *testing.T) {
+		r := newReconciler(operatorConfig(mdbv1.AtlasOperatorConfigSpec{SyncPeriod: &metav1.Duration{Duration: -time.Hour}}))
+		result := reconcile(t, r)
+
+		assert.Equal(t, corev1.ConditionFalse, readyStatus(result))
+		assert.Equal(t, string(workflow.OperatorConfigInvalid), result.Status.Conditions[0].Reason)
+	})
+
+	t.Run("Deleted config restores the defaults", func(t *testing.T) {
+		r := newReconciler()
+		r.LogLevel.SetLevel(zapcore.ErrorLevel)
+		r.Live.Store(Settings{ObjectDeletionProtection: true})
+		workflow.ConfigureRetry(workflow.RetryIntervals{Transient: time.Second, MaxTransient: time.Second, Permanent: time.Second, MaxPermanent: time.Second})
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "operator"}})
+		require.NoError(t, err)
+		assert.Equal(t, zapcore.InfoLevel, r.LogLevel.Level())
+		assert.Equal(t, workflow.DefaultRetryIntervals(), workflow.CurrentRetryIntervals())
+		assert.False(t, r.Live.ObjectDeletionProtection())
+		assert.Equal(t, "https://cloud.mongodb.com/", r.Live.AtlasDomain())
+	})
+}
+
+func TestLoad(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	t.Run("Missing config", func(t *testing.T) {
+		settings, watch := Load(context.Background(), fake.NewClientBuilder().WithScheme(scheme).Build(), "operator", defaultSettings(), zap.S())
+		assert.True(t, watch)
+		assert.Equal(t, defaultSettings(), settings)
+	})
+
+	t.Run("Config overrides the settings", func(t *testing.T) {
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&mdbv1.AtlasOperatorConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator"},
+			Spec:       mdbv1.AtlasOperatorConfigSpec{WatchedNamespaces: []string{"ns"}},
+		}).Build()
+		settings, watch := Load(context.Background(), kubeClient, "operator", defaultSettings(), zap.S())
+		assert.True(t, watch)
+		assert.Equal(t, []string{"ns"}, settings.WatchedNamespaces)
+	})
+
+	t.Run("Config can't be read", func(t *testing.T) {
+		reader := forbiddenReader{fake.NewClientBuilder().WithScheme(scheme).Build()}
+		settings, watch := Load(context.Background(), reader, "operator", defaultSettings(), zap.S())
+		assert.False(t, watch)
+		assert.Equal(t, defaultSettings(), settings)
+	})
+}
+
+// forbiddenReader mimics the operator installed without the permissions to read the cluster-scoped resources
+type forbiddenReader struct {
+	client.Reader
+}
+
+func (r forbiddenReader) Get(_ context.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return apiErrors.NewForbidden(schema.GroupResource{Group: "atlas.mongodb.com", Resource: "atlasoperatorconfigs"}, key.Name, nil)
+}
+
+func readyStatus(operatorConfig *mdbv1.AtlasOperatorConfig) corev1.ConditionStatus {
+	for _, condition := range operatorConfig.Status.Conditions {
+		if condition.Type == status.ReadyType {
+			return condition.Status
+		}
+	}
+	return ""
+}
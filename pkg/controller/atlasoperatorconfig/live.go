@@ -0,0 +1,62 @@
+package atlasoperatorconfig
+
+import (
+	"sync/atomic"
+)
+
+// LiveSettings are the settings the reconcilers read on each reconciliation, so that the changes of the
+// AtlasOperatorConfig apply without restarting the operator. It's safe to be read while the settings are stored.
+// A nil LiveSettings reads as the zero settings and ignores the stores.
+type LiveSettings struct {
+	value atomic.Pointer[liveValues]
+}
+
+type liveValues struct {
+	objectDeletionProtection    bool
+	subObjectDeletionProtection bool
+	atlasDomain                 string
+}
+
+// NewLiveSettings returns the live settings initialized with the settings the operator starts with
+func NewLiveSettings(settings Settings) *LiveSettings {
+	live := &LiveSettings{}
+	live.Store(settings)
+	return live
+}
+
+// Store replaces the live settings with the ones of the settings
+func (l *LiveSettings) Store(settings Settings) {
+	if l == nil {
+		return
+	}
+	l.value.Store(&liveValues{
+		objectDeletionProtection:    settings.ObjectDeletionProtection,
+		subObjectDeletionProtection: settings.SubObjectDeletionProtection,
+		atlasDomain:                 settings.AtlasDomain,
+	})
+}
+
+// ObjectDeletionProtection returns if the Atlas resources are kept when the Kubernetes resources are deleted
+func (l *LiveSettings) ObjectDeletionProtection() bool {
+	return l.load().objectDeletionProtection
+}
+
+// SubObjectDeletionProtection returns if the Atlas sub-resources not managed by the operator are kept
+func (l *LiveSettings) SubObjectDeletionProtection() bool {
+	return l.load().subObjectDeletionProtection
+}
+
+// AtlasDomain returns the Atlas URL domain name the clients connect to
+func (l *LiveSettings) AtlasDomain() string {
+	return l.load().atlasDomain
+}
+
+func (l *LiveSettings) load() liveValues {
+	if l == nil {
+		return liveValues{}
+	}
+	if value := l.value.Load(); value != nil {
+		return *value
+	}
+	return liveValues{}
+}
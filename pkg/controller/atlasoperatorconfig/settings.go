@@ -0,0 +1,134 @@
+package atlasoperatorconfig
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// Settings are the settings the operator runs with
+type Settings struct {
+	LogLevel                    zapcore.Level
+	ObjectDeletionProtection    bool
+	SubObjectDeletionProtection bool
+	SyncPeriod                  time.Duration
+	AtlasDomain                 string
+	Retry                       workflow.RetryIntervals
+	// WatchedNamespaces is empty if the operator watches all the namespaces
+	WatchedNamespaces []string
+}
+
+// WithConfig returns the settings overridden by the ones specified in the AtlasOperatorConfig
+func (s Settings) WithConfig(spec mdbv1.AtlasOperatorConfigSpec) (Settings, error) {
+	result := s
+	if spec.LogLevel != "" {
+		if err := result.LogLevel.UnmarshalText([]byte(spec.LogLevel)); err != nil {
+			return s, fmt.Errorf("invalid log level: %w", err)
+		}
+	}
+	if spec.ObjectDeletionProtection != nil {
+		result.ObjectDeletionProtection = *spec.ObjectDeletionProtection
+	}
+	if spec.SubObjectDeletionProtection != nil {
+		result.SubObjectDeletionProtection = *spec.SubObjectDeletionProtection
+	}
+	if spec.SyncPeriod != nil {
+		if spec.SyncPeriod.Duration <= 0 {
+			return s, errors.New("the sync period must be positive")
+		}
+		result.SyncPeriod = spec.SyncPeriod.Duration
+	}
+	if spec.AtlasDomain != "" {
+		result.AtlasDomain = spec.AtlasDomain
+	}
+	if spec.Retry != nil {
+		override := func(target *time.Duration, value *metav1.Duration) {
+			if value != nil {
+				*target = value.Duration
+			}
+		}
+		override(&result.Retry.Transient, spec.Retry.Transient)
+		override(&result.Retry.MaxTransient, spec.Retry.MaxTransient)
+		override(&result.Retry.Permanent, spec.Retry.Permanent)
+		override(&result.Retry.MaxPermanent, spec.Retry.MaxPermanent)
+		if result.Retry.Transient <= 0 || result.Retry.Permanent <= 0 {
+			return s, errors.New("the retry intervals must be positive")
+		}
+		if result.Retry.Transient > result.Retry.MaxTransient || result.Retry.Permanent > result.Retry.MaxPermanent {
+			return s, errors.New("the retry intervals must not exceed the maximum ones")
+		}
+	}
+	if len(spec.WatchedNamespaces) > 0 {
+		result.WatchedNamespaces = NormalizeNamespaces(spec.WatchedNamespaces)
+	}
+	return result, nil
+}
+
+// WithRuntimeSettings returns the settings with the ones which can be changed at runtime taken from the other settings
+func (s Settings) WithRuntimeSettings(other Settings) Settings {
+	s.LogLevel = other.LogLevel
+	s.Retry = other.Retry
+	s.ObjectDeletionProtection = other.ObjectDeletionProtection
+	s.SubObjectDeletionProtection = other.SubObjectDeletionProtection
+	s.AtlasDomain = other.AtlasDomain
+	return s
+}
+
+// RestartRequired returns the names of the settings which differ from the other ones and are applied only once the
+// operator restarts
+func (s Settings) RestartRequired(other Settings) []string {
+	var names []string
+	if s.SyncPeriod != other.SyncPeriod {
+		names = append(names, "syncPeriod")
+	}
+	if strings.Join(s.WatchedNamespaces, ",") != strings.Join(other.WatchedNamespaces, ",") {
+		names = append(names, "watchedNamespaces")
+	}
+	return names
+}
+
+func (s Settings) toStatus() status.OperatorConfiguration {
+	return status.OperatorConfiguration{
+		LogLevel:                    s.LogLevel.String(),
+		ObjectDeletionProtection:    s.ObjectDeletionProtection,
+		SubObjectDeletionProtection: s.SubObjectDeletionProtection,
+		SyncPeriod:                  metav1.Duration{Duration: s.SyncPeriod},
+		AtlasDomain:                 s.AtlasDomain,
+		Retry: status.RetryIntervals{
+			Transient:    metav1.Duration{Duration: s.Retry.Transient},
+			MaxTransient: metav1.Duration{Duration: s.Retry.MaxTransient},
+			Permanent:    metav1.Duration{Duration: s.Retry.Permanent},
+			MaxPermanent: metav1.Duration{Duration: s.Retry.MaxPermanent},
+		},
+		WatchedNamespaces: s.WatchedNamespaces,
+	}
+}
+
+// NormalizeNamespaces returns the sorted unique namespaces. An empty namespace means all the namespaces are watched,
+// so the result is empty then.
+func NormalizeNamespaces(namespaces []string) []string {
+	unique := map[string]bool{}
+	for _, namespace := range namespaces {
+		namespace = strings.TrimSpace(namespace)
+		if namespace == "" {
+			return nil
+		}
+		unique[namespace] = true
+	}
+
+	result := make([]string, 0, len(unique))
+	for namespace := range unique {
+		result = append(result, namespace)
+	}
+	sort.Strings(result)
+	return result
+}
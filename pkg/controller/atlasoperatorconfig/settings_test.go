@@ -0,0 +1,81 @@
+package atlasoperatorconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
+)
+
+func defaultSettings() Settings {
+	return Settings{
+		LogLevel:    zapcore.InfoLevel,
+		SyncPeriod:  3 * time.Hour,
+		AtlasDomain: "https://cloud.mongodb.com/",
+		Retry:       workflow.DefaultRetryIntervals(),
+	}
+}
+
+func TestWithConfig(t *testing.T) {
+	t.Run("Empty spec keeps the settings", func(t *testing.T) {
+		settings, err := defaultSettings().WithConfig(mdbv1.AtlasOperatorConfigSpec{})
+		require.NoError(t, err)
+		assert.Equal(t, defaultSettings(), settings)
+	})
+
+	t.Run("Spec overrides the settings", func(t *testing.T) {
+		settings, err := defaultSettings().WithConfig(mdbv1.AtlasOperatorConfigSpec{
+			LogLevel:                 "debug",
+			ObjectDeletionProtection: toptr.MakePtr(true),
+			SyncPeriod:               &metav1.Duration{Duration: time.Hour},
+			Retry:                    &mdbv1.RetryIntervals{Transient: &metav1.Duration{Duration: time.Second}},
+			WatchedNamespaces:        []string{"ns2", "ns1", "ns2"},
+		})
+		require.NoError(t, err)
+
+		expected := defaultSettings()
+		expected.LogLevel = zapcore.DebugLevel
+		expected.ObjectDeletionProtection = true
+		expected.SyncPeriod = time.Hour
+		expected.Retry.Transient = time.Second
+		expected.WatchedNamespaces = []string{"ns1", "ns2"}
+		assert.Equal(t, expected, settings)
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		for _, spec := range []mdbv1.AtlasOperatorConfigSpec{
+			{LogLevel: "verbose"},
+			{SyncPeriod: &metav1.Duration{}},
+			{Retry: &mdbv1.RetryIntervals{Permanent: &metav1.Duration{Duration: -time.Minute}}},
+			{Retry: &mdbv1.RetryIntervals{Transient: &metav1.Duration{Duration: time.Hour}}},
+		} {
+			_, err := defaultSettings().WithConfig(spec)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestRestartRequired(t *testing.T) {
+	changed := defaultSettings()
+	changed.LogLevel = zapcore.ErrorLevel
+	changed.Retry.Transient = time.Second
+	changed.SubObjectDeletionProtection = true
+	changed.AtlasDomain = "https://cloud-qa.mongodb.com/"
+	assert.Empty(t, defaultSettings().RestartRequired(changed))
+
+	changed.WatchedNamespaces = []string{"ns"}
+	assert.Equal(t, []string{"watchedNamespaces"}, defaultSettings().RestartRequired(changed))
+}
+
+func TestNormalizeNamespaces(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, NormalizeNamespaces([]string{" b", "a", "b"}))
+	assert.Nil(t, NormalizeNamespaces([]string{"a", ""}))
+	assert.Empty(t, NormalizeNamespaces(nil))
+}
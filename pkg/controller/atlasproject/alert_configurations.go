@@ -13,7 +13,6 @@ import (
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 )
 
@@ -25,12 +24,12 @@ func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Con
 		specToSync := project.Spec.DeepCopy().AlertConfigurations
 
 		alertConfigurationCondition := status.AlertConfigurationReadyType
-		ctx := context.Background()
+		ctx := service.Context
 		if len(specToSync) == 0 {
 			service.UnsetCondition(alertConfigurationCondition)
 			return workflow.OK()
 		}
-		err := r.readAlertConfigurationsSecretsData(project, service, specToSync)
+		err := r.readAlertConfigurationsSecretsData(project, specToSync)
 		if err != nil {
 			service.SetConditionFalseMsg(alertConfigurationCondition, err.Error())
 			return workflow.Terminate(workflow.Internal, err.Error())
@@ -49,59 +48,46 @@ func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Con
 }
 
 // This method reads secrets refs and fills the secret data for the related Notification
-func (r *AtlasProjectReconciler) readAlertConfigurationsSecretsData(project *mdbv1.AtlasProject, service *workflow.Context, alertConfigs []mdbv1.AlertConfiguration) error {
-	resourcesToWatch := make([]watch.WatchedObject, 0)
+func (r *AtlasProjectReconciler) readAlertConfigurationsSecretsData(project *mdbv1.AtlasProject, alertConfigs []mdbv1.AlertConfiguration) error {
 	projectNs := project.Namespace
-	defer func() {
-		service.AddResourcesToWatch(resourcesToWatch...)
-		r.Log.Debugf("watching alert configuration secrets: %v\r\n", r.WatchedResources)
-	}()
 
 	for i := 0; i < len(alertConfigs); i++ {
 		ac := &alertConfigs[i]
 		for j := 0; j < len(ac.Notifications); j++ {
 			nf := &ac.Notifications[j]
 			var err error
-			var res *watch.WatchedObject
 			switch {
 			case nf.APITokenRef.Name != "":
-				nf.APIToken, res, err = readNotificationSecret(r.Client, nf.APITokenRef, projectNs, "APIToken")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.APIToken, err = readNotificationSecret(r.Client, nf.APITokenRef, projectNs, "APIToken")
 				if err != nil {
 					return err
 				}
 			case nf.DatadogAPIKeyRef.Name != "":
-				nf.DatadogAPIKey, res, err = readNotificationSecret(r.Client, nf.DatadogAPIKeyRef, projectNs, "DatadogAPIKey")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.DatadogAPIKey, err = readNotificationSecret(r.Client, nf.DatadogAPIKeyRef, projectNs, "DatadogAPIKey")
 				if err != nil {
 					return err
 				}
 			case nf.FlowdockAPITokenRef.Name != "":
-				nf.FlowdockAPIToken, res, err = readNotificationSecret(r.Client, nf.FlowdockAPITokenRef, projectNs, "FlowdockAPIToken")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.FlowdockAPIToken, err = readNotificationSecret(r.Client, nf.FlowdockAPITokenRef, projectNs, "FlowdockAPIToken")
 				if err != nil {
 					return err
 				}
 			case nf.OpsGenieAPIKeyRef.Name != "":
-				nf.OpsGenieAPIKey, res, err = readNotificationSecret(r.Client, nf.OpsGenieAPIKeyRef, projectNs, "OpsGenieAPIKey")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.OpsGenieAPIKey, err = readNotificationSecret(r.Client, nf.OpsGenieAPIKeyRef, projectNs, "OpsGenieAPIKey")
 				if err != nil {
 					return err
 				}
 			case nf.ServiceKeyRef.Name != "":
-				nf.ServiceKey, res, err = readNotificationSecret(r.Client, nf.ServiceKeyRef, projectNs, "ServiceKey")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.ServiceKey, err = readNotificationSecret(r.Client, nf.ServiceKeyRef, projectNs, "ServiceKey")
 				if err != nil {
 					return err
 				}
 			case nf.VictorOpsSecretRef.Name != "":
-				nf.VictorOpsAPIKey, res, err = readNotificationSecret(r.Client, nf.VictorOpsSecretRef, projectNs, "VictorOpsAPIKey")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.VictorOpsAPIKey, err = readNotificationSecret(r.Client, nf.VictorOpsSecretRef, projectNs, "VictorOpsAPIKey")
 				if err != nil {
 					return err
 				}
-				nf.VictorOpsRoutingKey, res, err = readNotificationSecret(r.Client, nf.VictorOpsSecretRef, projectNs, "VictorOpsRoutingKey")
-				resourcesToWatch = append(resourcesToWatch, *res)
+				nf.VictorOpsRoutingKey, err = readNotificationSecret(r.Client, nf.VictorOpsSecretRef, projectNs, "VictorOpsRoutingKey")
 				if err != nil {
 					return err
 				}
@@ -111,7 +97,7 @@ func (r *AtlasProjectReconciler) readAlertConfigurationsSecretsData(project *mdb
 	return nil
 }
 
-func readNotificationSecret(kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string, fieldName string) (string, *watch.WatchedObject, error) {
+func readNotificationSecret(kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string, fieldName string) (string, error) {
 	secret := &v1.Secret{}
 	var ns string
 	if res.Namespace == "" {
@@ -121,19 +107,18 @@ func readNotificationSecret(kubeClient client.Client, res common.ResourceRefName
 	}
 
 	secretObj := client.ObjectKey{Name: res.Name, Namespace: ns}
-	obj := &watch.WatchedObject{ResourceKind: "Secret", Resource: secretObj}
 
 	if err := kubeClient.Get(context.Background(), secretObj, secret); err != nil {
-		return "", obj, err
+		return "", err
 	}
 	val, exists := secret.Data[fieldName]
 	switch {
 	case !exists:
-		return "", obj, fmt.Errorf("secret '%s/%s' doesn't contain '%s' parameter", ns, res.Name, fieldName)
+		return "", fmt.Errorf("secret '%s/%s' doesn't contain '%s' parameter", ns, res.Name, fieldName)
 	case len(val) == 0:
-		return "", obj, fmt.Errorf("secret '%s/%s' contains an empty value for '%s' parameter", ns, res.Name, fieldName)
+		return "", fmt.Errorf("secret '%s/%s' contains an empty value for '%s' parameter", ns, res.Name, fieldName)
 	}
-	return string(val), obj, nil
+	return string(val), nil
 }
 
 func syncAlertConfigurations(context context.Context, service *workflow.Context, groupID string, alertSpec []mdbv1.AlertConfiguration) workflow.Result {
@@ -26,6 +26,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/validate"
 
 	"go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 
@@ -33,32 +34,43 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/authmode"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 )
 
 // AtlasProjectReconciler reconciles a AtlasProject object
 type AtlasProjectReconciler struct {
 	Client client.Client
-	watch.ResourceWatcher
-	Log                         *zap.SugaredLogger
-	Scheme                      *runtime.Scheme
-	AtlasDomain                 string
-	GlobalAPISecret             client.ObjectKey
-	GlobalPredicates            []predicate.Predicate
-	EventRecorder               record.EventRecorder
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
+	Log    *zap.SugaredLogger
+	Scheme *runtime.Scheme
+	// Settings are the operator settings which are changed at runtime by the AtlasOperatorConfig
+	Settings                *atlasoperatorconfig.LiveSettings
+	AtlasClients            *atlas.ClientRegistry
+	CredentialsChecker      *atlas.CredentialsChecker
+	GlobalAPISecret         client.ObjectKey
+	GlobalPredicates        []predicate.Predicate
+	EventRecorder           record.EventRecorder
+	MaxConcurrentReconciles int
+	// OperatorCredentialsChanged receives the events once the Operator credentials directory changes, the projects
+	// using the Operator credentials are reconciled then
+	OperatorCredentialsChanged <-chan event.GenericEvent
 }
 
 // Dev note: duplicate the permissions in both sections below to generate both Role and ClusterRoles
@@ -66,11 +78,13 @@ type AtlasProjectReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=default,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasteams,verbs=get;list;watch;create;update;patch;delete
@@ -78,7 +92,10 @@ type AtlasProjectReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasteams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasteams/status,verbs=get;update;patch
 
-func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (reconcileResult ctrl.Result, _ error) {
+	ctx, span := tracing.Start(ctx, "AtlasProject.Reconcile", tracing.ResourceAttributes(req.NamespacedName)...)
+	defer span.End()
+
 	log := r.Log.With("atlasproject", req.NamespacedName)
 
 	project := &mdbv1.AtlasProject{}
@@ -101,18 +118,13 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, project, log)
+	workflowCtx.Context = ctx
 	log.Infow("-> Starting AtlasProject reconciliation", "spec", project.Spec)
 
-	if project.ConnectionSecretObjectKey() != nil {
-		// Note, that we are not watching the global connection secret - seems there is no point in reconciling all
-		// the projects once that secret is changed
-		workflowCtx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "Secret", Resource: *project.ConnectionSecretObjectKey()})
-	}
-
 	// This update will make sure the status is always updated in case of any errors or successful result
 	defer func() {
+		reconcileResult = workflowCtx.ApplyBackoff(reconcileResult)
 		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, project)
-		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, project, r.Log)
@@ -128,9 +140,10 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	workflowCtx.SetConditionTrue(status.ValidationSucceeded)
 
-	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.ConnectionSecretObjectKey())
+	connection, err := atlas.ReadConnection(log, r.Client, r.GlobalAPISecret, project.Namespace, project.ConnectionSecretObjectKey(), project.Spec.TransportRef)
 	if err != nil {
 		result = workflow.Terminate(workflow.AtlasCredentialsNotProvided, err.Error())
+		workflowCtx.SetConditionFromResult(status.CredentialsResolvedType, result)
 		setCondition(workflowCtx, status.ProjectReadyType, result)
 		if errRm := customresource.ManageFinalizer(ctx, r.Client, project, customresource.UnsetFinalizer); errRm != nil {
 			result = workflow.Terminate(workflow.Internal, errRm.Error())
@@ -139,8 +152,9 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return result.ReconcileResult(), nil
 	}
 	workflowCtx.Connection = connection
+	setCredentialsResolved(workflowCtx, connection)
 
-	atlasClient, err := atlas.Client(r.AtlasDomain, connection, log)
+	atlasClient, err := r.AtlasClients.Client(r.Settings.AtlasDomain(), connection, log)
 	if err != nil {
 		result := workflow.Terminate(workflow.Internal, err.Error())
 		setCondition(workflowCtx, status.DeploymentReadyType, result)
@@ -148,7 +162,24 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	workflowCtx.Client = atlasClient
 
-	owner, err := customresource.IsOwner(project, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient))
+	if result = checkCredentials(workflowCtx, r.CredentialsChecker, project.ID()); !result.IsOk() {
+		setCondition(workflowCtx, status.ProjectReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBePlanned(project) {
+		plan, unplanned, err := r.planProject(workflowCtx, project)
+		if err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			workflowCtx.SetConditionFromResult(status.ReconciliationPlannedType, result)
+			return result.ReconcileResult(), nil
+		}
+		log.Infow("-> Planned AtlasProject reconciliation", "plan", plan, "unplanned", unplanned)
+		workflowCtx.SetPlan(plan, unplanned...)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	owner, err := customresource.IsOwner(project, r.Settings.ObjectDeletionProtection(), customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient))
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
 		workflowCtx.SetConditionFromResult(status.ProjectReadyType, result)
@@ -241,7 +272,7 @@ func (r *AtlasProjectReconciler) ensureDeletionFinalizer(ctx context.Context, wo
 
 	if !project.GetDeletionTimestamp().IsZero() {
 		if customresource.HaveFinalizer(project, customresource.FinalizerLabel) {
-			if customresource.IsResourceProtected(project, r.ObjectDeletionProtection) {
+			if customresource.IsResourceProtected(project, r.Settings.ObjectDeletionProtection()) {
 				log.Info("Not removing Project from Atlas as per configuration")
 				result = workflow.OK()
 			} else {
@@ -272,74 +303,82 @@ func (r *AtlasProjectReconciler) ensureDeletionFinalizer(ctx context.Context, wo
 }
 
 // ensureProjectResources ensures IP Access List, Private Endpoints, Integrations, Maintenance Window and Encryption at Rest
+// Every step is traced in its own span.
 func (r *AtlasProjectReconciler) ensureProjectResources(ctx context.Context, workflowCtx *workflow.Context, project *mdbv1.AtlasProject) (results []workflow.Result) {
 	for k, v := range project.Annotations {
 		workflowCtx.Log.Debugf(k)
 		workflowCtx.Log.Debugf(v)
 	}
 
-	var result workflow.Result
-	if result = ensureIPAccessList(ctx, workflowCtx, atlas.CustomIPAccessListStatus(&workflowCtx.Client), project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.IPAccessListReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = ensurePrivateEndpoint(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.PrivateEndpointReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = ensureProviderAccessStatus(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.CloudProviderAccessReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = ensureNetworkPeers(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.NetworkPeerReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = r.ensureAlertConfigurations(workflowCtx, project); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.AlertConfigurationReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = r.ensureIntegration(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.IntegrationReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = ensureMaintenanceWindow(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.MaintenanceWindowReadyType), "")
+	steps := []struct {
+		name      string
+		condition status.ConditionType
+		ensure    func(ctx context.Context) workflow.Result
+	}{
+		{"ensureIPAccessList", status.IPAccessListReadyType, func(ctx context.Context) workflow.Result {
+			return ensureIPAccessList(ctx, workflowCtx, atlas.CustomIPAccessListStatus(&workflowCtx.Client), project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensurePrivateEndpoint", status.PrivateEndpointReadyType, func(ctx context.Context) workflow.Result {
+			return ensurePrivateEndpoint(workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureProviderAccessStatus", status.CloudProviderAccessReadyType, func(ctx context.Context) workflow.Result {
+			return ensureProviderAccessStatus(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureNetworkPeers", status.NetworkPeerReadyType, func(ctx context.Context) workflow.Result {
+			return ensureNetworkPeers(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureAlertConfigurations", status.AlertConfigurationReadyType, func(ctx context.Context) workflow.Result {
+			return r.ensureAlertConfigurations(workflowCtx, project)
+		}},
+		{"ensureIntegration", status.IntegrationReadyType, func(ctx context.Context) workflow.Result {
+			return r.ensureIntegration(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureMaintenanceWindow", status.MaintenanceWindowReadyType, func(ctx context.Context) workflow.Result {
+			return ensureMaintenanceWindow(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureEncryptionAtRest", status.EncryptionAtRestReadyType, func(ctx context.Context) workflow.Result {
+			return r.ensureEncryptionAtRest(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureAuditing", status.AuditingReadyType, func(ctx context.Context) workflow.Result {
+			return ensureAuditing(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureProjectSettings", status.ProjectSettingsReadyType, func(ctx context.Context) workflow.Result {
+			return ensureProjectSettings(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureCustomRoles", status.ProjectCustomRolesReadyType, func(ctx context.Context) workflow.Result {
+			return ensureCustomRoles(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+		{"ensureAssignedTeams", status.ProjectTeamsReadyType, func(ctx context.Context) workflow.Result {
+			return r.ensureAssignedTeams(ctx, workflowCtx, project, r.Settings.SubObjectDeletionProtection())
+		}},
+	}
+
+	for _, step := range steps {
+		result := traceStep(ctx, workflowCtx, step.name, step.ensure)
+		if result.IsOk() {
+			r.EventRecorder.Event(project, "Normal", string(step.condition), "")
+		}
+		results = append(results, result)
 	}
-	results = append(results, result)
 
-	if result = r.ensureEncryptionAtRest(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.EncryptionAtRestReadyType), "")
-	}
-	results = append(results, result)
+	return results
+}
 
-	if result = ensureAuditing(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.AuditingReadyType), "")
-	}
-	results = append(results, result)
+// traceStep runs the reconciliation step in a child span. The workflow context carries the span during the step so that
+// the Atlas API calls of the step are traced as its children.
+func traceStep(ctx context.Context, workflowCtx *workflow.Context, name string, step func(ctx context.Context) workflow.Result) workflow.Result {
+	ctx, span := tracing.Start(ctx, name)
+	defer span.End()
 
-	if result = ensureProjectSettings(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.ProjectSettingsReadyType), "")
-	}
-	results = append(results, result)
+	parentCtx := workflowCtx.Context
+	workflowCtx.Context = ctx
+	defer func() { workflowCtx.Context = parentCtx }()
 
-	if result = ensureCustomRoles(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.ProjectCustomRolesReadyType), "")
-	}
-	results = append(results, result)
-
-	if result = r.ensureAssignedTeams(ctx, workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
-		r.EventRecorder.Event(project, "Normal", string(status.ProjectTeamsReadyType), "")
+	result := step(ctx)
+	if result.IsWarning() {
+		span.SetStatus(codes.Error, result.GetMessage())
 	}
-	results = append(results, result)
-
-	return results
+	return result
 }
 
 func (r *AtlasProjectReconciler) deleteAtlasProject(ctx context.Context, atlasClient mongodbatlas.Client, project *mdbv1.AtlasProject) (err error) {
@@ -357,12 +396,125 @@ func (r *AtlasProjectReconciler) deleteAtlasProject(ctx context.Context, atlasCl
 }
 
 func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(context.Background(), &mdbv1.AtlasProject{}, watch.ProjectSecretsIndex, watch.ProjectSecretRefs); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &mdbv1.AtlasProject{}, watch.ProjectTeamsIndex, watch.ProjectTeamRefs); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(context.Background(), &mdbv1.AtlasProject{}, watch.ProjectDefaultCredentialsIndex, watch.ProjectDefaultCredentialsRefs); err != nil {
+		return err
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasProject").
 		For(&mdbv1.AtlasProject{}, builder.WithPredicates(r.GlobalPredicates...)).
-		Watches(&source.Kind{Type: &corev1.Secret{}}, watch.NewSecretHandler(r.WatchedResources)).
-		Watches(&source.Kind{Type: &mdbv1.AtlasTeam{}}, watch.NewAtlasTeamHandler(r.WatchedResources)).
-		Complete(r)
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, watch.NewSecretHandler(watch.IndexedDependants(mgr.GetClient(), &mdbv1.AtlasProjectList{}, watch.ProjectSecretsIndex))).
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			watch.NewSecretHandler(watch.NamespaceIndexedDependants(mgr.GetClient(), &mdbv1.AtlasProjectList{}, watch.ProjectDefaultCredentialsIndex)),
+			builder.WithPredicates(watch.HasLabelPredicate(atlas.DefaultCredentialsLabelKey, atlas.DefaultCredentialsLabelVal)),
+		).
+		Watches(&source.Kind{Type: &mdbv1.AtlasTeam{}}, watch.NewAtlasTeamHandler(watch.IndexedDependants(mgr.GetClient(), &mdbv1.AtlasProjectList{}, watch.ProjectTeamsIndex)))
+	if r.OperatorCredentialsChanged != nil {
+		controllerBuilder = controllerBuilder.Watches(
+			&source.Channel{Source: r.OperatorCredentialsChanged},
+			handler.EnqueueRequestsFromMapFunc(r.projectsUsingOperatorCredentials),
+		)
+	}
+	return controllerBuilder.Complete(r)
+}
+
+// projectsUsingOperatorCredentials returns the projects which read any of the Atlas API credentials from the Operator
+// Secret or directory, including the projects whose credentials weren't resolved yet
+func (r *AtlasProjectReconciler) projectsUsingOperatorCredentials(_ client.Object) []reconcile.Request {
+	projects := &mdbv1.AtlasProjectList{}
+	if err := r.Client.List(context.Background(), projects); err != nil {
+		r.Log.Errorf("failed to list the AtlasProjects using the Operator credentials: %s", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range projects.Items {
+		if usesOperatorCredentials(projects.Items[i].Status.Credentials) {
+			requests = append(requests, reconcile.Request{NamespacedName: kube.ObjectKeyFromObject(&projects.Items[i])})
+		}
+	}
+	return requests
+}
+
+func usesOperatorCredentials(credentials *status.ProjectCredentials) bool {
+	if credentials == nil {
+		return true
+	}
+	sources := []*status.CredentialsSource{&credentials.OrgID, credentials.PublicAPIKey, credentials.PrivateAPIKey, credentials.ClientID, credentials.ClientSecret}
+	for _, source := range sources {
+		if source == nil {
+			continue
+		}
+		if source.Source == string(atlas.OperatorCredentials) || source.Source == string(atlas.FileCredentials) {
+			return true
+		}
+	}
+	return false
+}
+
+// setCredentialsResolved reports the Secrets the fields of the Atlas API credentials were read from, the values of the
+// fields are never reported
+func setCredentialsResolved(ctx *workflow.Context, connection atlas.Connection) {
+	toStatus := func(source atlas.FieldSource) *status.CredentialsSource {
+		if source.Source == atlas.FileCredentials {
+			return &status.CredentialsSource{Source: string(source.Source), Path: source.Path}
+		}
+		return &status.CredentialsSource{
+			Source:    string(source.Source),
+			SecretRef: &common.ResourceRefNamespaced{Name: source.SecretRef.Name, Namespace: source.SecretRef.Namespace},
+		}
+	}
+	credentials := &status.ProjectCredentials{
+		AuthMode: string(connection.AuthMode()),
+		OrgID:    *toStatus(connection.Sources.OrgID),
+	}
+	if connection.AuthMode() == atlas.ServiceAccountAuth {
+		credentials.ClientID = toStatus(connection.Sources.ClientID)
+		credentials.ClientSecret = toStatus(connection.Sources.ClientSecret)
+	} else {
+		credentials.PublicAPIKey = toStatus(connection.Sources.PublicKey)
+		credentials.PrivateAPIKey = toStatus(connection.Sources.PrivateKey)
+	}
+	ctx.EnsureStatusOption(status.AtlasProjectCredentialsOption(credentials))
+	ctx.EnsureCondition(status.Condition{
+		Type:    status.CredentialsResolvedType,
+		Status:  corev1.ConditionTrue,
+		Message: connection.DescribeSources(),
+	})
+}
+
+// checkCredentials verifies the Atlas API credentials can be used to manage the project before any change is made
+func checkCredentials(ctx *workflow.Context, checker *atlas.CredentialsChecker, projectID string) workflow.Result {
+	err := checker.Check(ctx.Context, &ctx.Client, ctx.Connection, projectID)
+	if err == nil {
+		ctx.SetConditionTrue(status.CredentialsValidType)
+		return workflow.OK()
+	}
+
+	reason := workflow.Internal
+	var credentialsErr *atlas.CredentialsError
+	if errors.As(err, &credentialsErr) {
+		switch credentialsErr.Problem {
+		case atlas.CredentialsInvalid:
+			reason = workflow.AtlasAPIKeyInvalid
+		case atlas.CredentialsMissingRole:
+			reason = workflow.AtlasAPIKeyMissingRole
+		case atlas.CredentialsIPNotAllowed:
+			reason = workflow.AtlasAPIAccessNotAllowed
+		}
+	}
+	result := workflow.Terminate(reason, err.Error())
+	ctx.SetConditionFromResult(status.CredentialsValidType, result)
+	return result
 }
 
 // setCondition sets the condition from the result and logs the warnings
@@ -0,0 +1,21 @@
+package atlasproject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+func TestUsesOperatorCredentials(t *testing.T) {
+	projectSource := status.CredentialsSource{Source: "AtlasProject", SecretRef: &common.ResourceRefNamespaced{Name: "keys", Namespace: "ns"}}
+	fileSource := status.CredentialsSource{Source: "File", Path: "/etc/atlas"}
+
+	assert.True(t, usesOperatorCredentials(nil))
+	assert.False(t, usesOperatorCredentials(&status.ProjectCredentials{OrgID: projectSource, PublicAPIKey: &projectSource, PrivateAPIKey: &projectSource}))
+	assert.True(t, usesOperatorCredentials(&status.ProjectCredentials{OrgID: fileSource, PublicAPIKey: &projectSource, PrivateAPIKey: &projectSource}))
+	assert.False(t, usesOperatorCredentials(&status.ProjectCredentials{OrgID: projectSource, ClientID: &projectSource, ClientSecret: &projectSource}))
+	assert.True(t, usesOperatorCredentials(&status.ProjectCredentials{OrgID: projectSource, ClientID: &projectSource, ClientSecret: &fileSource}))
+}
@@ -16,7 +16,6 @@ import (
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
 
@@ -28,7 +27,7 @@ const (
 )
 
 func (r *AtlasProjectReconciler) ensureEncryptionAtRest(ctx context.Context, workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool) workflow.Result {
-	if err := readEncryptionAtRestSecrets(r.Client, workflowCtx, project.Spec.EncryptionAtRest, project.Namespace); err != nil {
+	if err := readEncryptionAtRestSecrets(r.Client, project.Spec.EncryptionAtRest, project.Namespace); err != nil {
 		workflowCtx.UnsetCondition(status.EncryptionAtRestReadyType)
 		return workflow.Terminate(workflow.ProjectEncryptionAtRestReady, err.Error())
 	}
@@ -66,31 +65,25 @@ func (r *AtlasProjectReconciler) ensureEncryptionAtRest(ctx context.Context, wor
 	return workflow.OK()
 }
 
-func readEncryptionAtRestSecrets(kubeClient client.Client, service *workflow.Context, encRest *mdbv1.EncryptionAtRest, parentNs string) error {
+func readEncryptionAtRestSecrets(kubeClient client.Client, encRest *mdbv1.EncryptionAtRest, parentNs string) error {
 	if encRest == nil {
 		return nil
 	}
 
 	if encRest.AwsKms.Enabled != nil && *encRest.AwsKms.Enabled && encRest.AwsKms.SecretRef.Name != "" {
-		watchObj, err := readAndFillAWSSecret(kubeClient, parentNs, &encRest.AwsKms)
-		service.AddResourcesToWatch(*watchObj)
-		if err != nil {
+		if err := readAndFillAWSSecret(kubeClient, parentNs, &encRest.AwsKms); err != nil {
 			return err
 		}
 	}
 
 	if encRest.GoogleCloudKms.Enabled != nil && *encRest.GoogleCloudKms.Enabled && encRest.GoogleCloudKms.SecretRef.Name != "" {
-		watchObj, err := readAndFillGoogleSecret(kubeClient, parentNs, &encRest.GoogleCloudKms)
-		service.AddResourcesToWatch(*watchObj)
-		if err != nil {
+		if err := readAndFillGoogleSecret(kubeClient, parentNs, &encRest.GoogleCloudKms); err != nil {
 			return err
 		}
 	}
 
 	if encRest.AzureKeyVault.Enabled != nil && *encRest.AzureKeyVault.Enabled && encRest.AzureKeyVault.SecretRef.Name != "" {
-		watchObj, err := readAndFillAzureSecret(kubeClient, parentNs, &encRest.AzureKeyVault)
-		service.AddResourcesToWatch(*watchObj)
-		if err != nil {
+		if err := readAndFillAzureSecret(kubeClient, parentNs, &encRest.AzureKeyVault); err != nil {
 			return err
 		}
 	}
@@ -98,35 +91,35 @@ func readEncryptionAtRestSecrets(kubeClient client.Client, service *workflow.Con
 	return nil
 }
 
-func readAndFillAWSSecret(kubeClient client.Client, parentNs string, awsKms *mdbv1.AwsKms) (*watch.WatchedObject, error) {
-	fieldData, watchObj, err := readSecretData(kubeClient, awsKms.SecretRef, parentNs, "CustomerMasterKeyID", "Region", "RoleID")
+func readAndFillAWSSecret(kubeClient client.Client, parentNs string, awsKms *mdbv1.AwsKms) error {
+	fieldData, err := readSecretData(kubeClient, awsKms.SecretRef, parentNs, "CustomerMasterKeyID", "Region", "RoleID")
 	if err != nil {
-		return watchObj, err
+		return err
 	}
 
 	awsKms.CustomerMasterKeyID = fieldData["CustomerMasterKeyID"]
 	awsKms.Region = fieldData["Region"]
 	awsKms.RoleID = fieldData["RoleID"]
 
-	return watchObj, nil
+	return nil
 }
 
-func readAndFillGoogleSecret(kubeClient client.Client, parentNs string, gkms *mdbv1.GoogleCloudKms) (*watch.WatchedObject, error) {
-	fieldData, watchObj, err := readSecretData(kubeClient, gkms.SecretRef, parentNs, "ServiceAccountKey", "KeyVersionResourceID")
+func readAndFillGoogleSecret(kubeClient client.Client, parentNs string, gkms *mdbv1.GoogleCloudKms) error {
+	fieldData, err := readSecretData(kubeClient, gkms.SecretRef, parentNs, "ServiceAccountKey", "KeyVersionResourceID")
 	if err != nil {
-		return watchObj, err
+		return err
 	}
 
 	gkms.ServiceAccountKey = fieldData["ServiceAccountKey"]
 	gkms.KeyVersionResourceID = fieldData["KeyVersionResourceID"]
 
-	return watchObj, nil
+	return nil
 }
 
-func readAndFillAzureSecret(kubeClient client.Client, parentNs string, azureVault *mdbv1.AzureKeyVault) (*watch.WatchedObject, error) {
-	fieldData, watchObj, err := readSecretData(kubeClient, azureVault.SecretRef, parentNs, "ClientID", "Secret", "AzureEnvironment", "SubscriptionID", "ResourceGroupName", "KeyVaultName", "KeyIdentifier", "TenantID")
+func readAndFillAzureSecret(kubeClient client.Client, parentNs string, azureVault *mdbv1.AzureKeyVault) error {
+	fieldData, err := readSecretData(kubeClient, azureVault.SecretRef, parentNs, "ClientID", "Secret", "AzureEnvironment", "SubscriptionID", "ResourceGroupName", "KeyVaultName", "KeyIdentifier", "TenantID")
 	if err != nil {
-		return watchObj, err
+		return err
 	}
 
 	azureVault.ClientID = fieldData["ClientID"]
@@ -138,11 +131,11 @@ func readAndFillAzureSecret(kubeClient client.Client, parentNs string, azureVaul
 	azureVault.KeyVaultName = fieldData["KeyVaultName"]
 	azureVault.KeyIdentifier = fieldData["KeyIdentifier"]
 
-	return watchObj, nil
+	return nil
 }
 
 // Return all requested field from a secret
-func readSecretData(kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string, fieldNames ...string) (map[string]string, *watch.WatchedObject, error) {
+func readSecretData(kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string, fieldNames ...string) (map[string]string, error) {
 	secret := &v1.Secret{}
 	var ns string
 	if res.Namespace == "" {
@@ -154,10 +147,9 @@ func readSecretData(kubeClient client.Client, res common.ResourceRefNamespaced,
 	result := map[string]string{}
 
 	secretObj := client.ObjectKey{Name: res.Name, Namespace: ns}
-	obj := &watch.WatchedObject{ResourceKind: "Secret", Resource: secretObj}
 
 	if err := kubeClient.Get(context.Background(), secretObj, secret); err != nil {
-		return result, obj, err
+		return result, err
 	}
 
 	missingFields := []string{}
@@ -170,10 +162,10 @@ func readSecretData(kubeClient client.Client, res common.ResourceRefNamespaced,
 	}
 
 	if len(missingFields) != 0 {
-		return result, obj, fmt.Errorf("the following fields are either missing or their values are empty: %s", strings.Join(missingFields, ", "))
+		return result, fmt.Errorf("the following fields are either missing or their values are empty: %s", strings.Join(missingFields, ", "))
 	}
 
-	return result, obj, nil
+	return result, nil
 }
 
 func createOrDeleteEncryptionAtRests(ctx *workflow.Context, projectID string, project *mdbv1.AtlasProject) workflow.Result {
@@ -199,7 +191,7 @@ func createOrDeleteEncryptionAtRests(ctx *workflow.Context, projectID string, pr
 }
 
 func fetchEncryptionAtRests(ctx *workflow.Context, projectID string) (*mongodbatlas.EncryptionAtRest, error) {
-	encryptionAtRestsInAtlas, _, err := ctx.Client.EncryptionsAtRest.Get(context.Background(), projectID)
+	encryptionAtRestsInAtlas, _, err := ctx.Client.EncryptionsAtRest.Get(ctx.Context, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +211,7 @@ func syncEncryptionAtRestsInAtlas(ctx *workflow.Context, projectID string, proje
 		return err
 	}
 
-	if _, _, err := ctx.Client.EncryptionsAtRest.Create(context.Background(), &requestBody); err != nil { // Create() sends PATCH request
+	if _, _, err := ctx.Client.EncryptionsAtRest.Create(ctx.Context, &requestBody); err != nil { // Create() sends PATCH request
 		return err
 	}
 
@@ -242,7 +234,7 @@ func normalizeAwsKms(ctx *workflow.Context, projectID string, awsKms *mongodbatl
 	}
 
 	// assume that role ID is set as AWS ARN
-	resp, _, err := ctx.Client.CloudProviderAccess.ListRoles(context.Background(), projectID)
+	resp, _, err := ctx.Client.CloudProviderAccess.ListRoles(ctx.Context, projectID)
 	if err != nil {
 		return err
 	}
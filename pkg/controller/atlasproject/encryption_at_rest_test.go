@@ -16,6 +16,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/internal/mocks/atlas"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
@@ -232,7 +233,7 @@ func TestEnsureEncryptionAtRest(t *testing.T) {
 			Client: atlasClient,
 		}
 		reconciler := &AtlasProjectReconciler{
-			SubObjectDeletionProtection: true,
+			Settings: atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{SubObjectDeletionProtection: true}),
 		}
 		result := reconciler.ensureEncryptionAtRest(context.TODO(), workflowCtx, akoProject, true)
 
@@ -283,7 +284,7 @@ func TestEnsureEncryptionAtRest(t *testing.T) {
 			Client: atlasClient,
 		}
 		reconciler := &AtlasProjectReconciler{
-			SubObjectDeletionProtection: true,
+			Settings: atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{SubObjectDeletionProtection: true}),
 		}
 		result := reconciler.ensureEncryptionAtRest(context.TODO(), workflowCtx, akoProject, true)
 
@@ -320,8 +321,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			AwsKms: mdbv1.AwsKms{
 				Enabled: toptr.MakePtr(true),
@@ -332,7 +331,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.Nil(t, err)
 
 		assert.Equal(t, string(secretData["CustomerMasterKeyID"]), encRest.AwsKms.CustomerMasterKeyID)
@@ -361,8 +360,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			AwsKms: mdbv1.AwsKms{
 				Enabled: toptr.MakePtr(true),
@@ -372,7 +369,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test-fallback-ns")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test-fallback-ns")
 		assert.Nil(t, err)
 
 		assert.Equal(t, string(secretData["CustomerMasterKeyID"]), encRest.AwsKms.CustomerMasterKeyID)
@@ -401,8 +398,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			AwsKms: mdbv1.AwsKms{
 				Enabled: toptr.MakePtr(true),
@@ -413,7 +408,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.NotNil(t, err)
 	})
 
@@ -437,8 +432,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			GoogleCloudKms: mdbv1.GoogleCloudKms{
 				Enabled: toptr.MakePtr(true),
@@ -448,7 +441,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.Nil(t, err)
 
 		assert.Equal(t, string(secretData["ServiceAccountKey"]), encRest.GoogleCloudKms.ServiceAccountKey)
@@ -474,8 +467,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			GoogleCloudKms: mdbv1.GoogleCloudKms{
 				Enabled: toptr.MakePtr(true),
@@ -485,7 +476,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.NotNil(t, err)
 	})
 
@@ -515,8 +506,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			AzureKeyVault: mdbv1.AzureKeyVault{
 				Enabled: toptr.MakePtr(true),
@@ -526,7 +515,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.Nil(t, err)
 
 		assert.Equal(t, string(secretData["ClientID"]), encRest.AzureKeyVault.ClientID)
@@ -561,8 +550,6 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}...).Build()
 
-		service := &workflow.Context{}
-
 		encRest := &mdbv1.EncryptionAtRest{
 			AzureKeyVault: mdbv1.AzureKeyVault{
 				Enabled: toptr.MakePtr(true),
@@ -572,7 +559,7 @@ func TestReadEncryptionAtRestSecrets(t *testing.T) {
 			},
 		}
 
-		err := readEncryptionAtRestSecrets(kk, service, encRest, "test")
+		err := readEncryptionAtRestSecrets(kk, encRest, "test")
 		assert.NotNil(t, err)
 	})
 }
@@ -88,7 +88,7 @@ func (r *AtlasProjectReconciler) createOrDeleteIntegrations(ctx *workflow.Contex
 }
 
 func fetchIntegrations(ctx *workflow.Context, projectID string) (*mongodbatlas.ThirdPartyIntegrations, error) {
-	integrationsInAtlas, _, err := ctx.Client.Integrations.List(context.Background(), projectID)
+	integrationsInAtlas, _, err := ctx.Client.Integrations.List(ctx.Context, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (r *AtlasProjectReconciler) updateIntegrationsAtlas(ctx *workflow.Context,
 		t := mongodbatlas.ThirdPartyIntegration(atlasIntegration)
 		if &t != kubeIntegration {
 			ctx.Log.Debugf("Try to update integration: %s", kubeIntegration.Type)
-			if _, _, err := ctx.Client.Integrations.Replace(context.Background(), projectID, kubeIntegration.Type, kubeIntegration); err != nil {
+			if _, _, err := ctx.Client.Integrations.Replace(ctx.Context, projectID, kubeIntegration.Type, kubeIntegration); err != nil {
 				return workflow.Terminate(workflow.ProjectIntegrationRequest, "Can not convert integration")
 			}
 		}
@@ -117,7 +117,7 @@ func (r *AtlasProjectReconciler) updateIntegrationsAtlas(ctx *workflow.Context,
 
 func deleteIntegrationsFromAtlas(ctx *workflow.Context, projectID string, integrationsToRemove []set.Identifiable) error {
 	for _, integration := range integrationsToRemove {
-		if _, err := ctx.Client.Integrations.Delete(context.Background(), projectID, integration.Identifier().(string)); err != nil {
+		if _, err := ctx.Client.Integrations.Delete(ctx.Context, projectID, integration.Identifier().(string)); err != nil {
 			return err
 		}
 		ctx.Log.Debugf("Third Party Integration deleted: %s", integration.Identifier())
@@ -132,7 +132,7 @@ func (r *AtlasProjectReconciler) createIntegrationsInAtlas(ctx *workflow.Context
 			return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("cannot convert integration: %s", err.Error()))
 		}
 
-		_, resp, err := ctx.Client.Integrations.Create(context.Background(), projectID, integration.Type, integration)
+		_, resp, err := ctx.Client.Integrations.Create(ctx.Context, projectID, integration.Type, integration)
 		if resp.StatusCode != http.StatusOK {
 			ctx.Log.Debugw("Create request failed", "Status", resp.Status, "Integration", integration)
 		}
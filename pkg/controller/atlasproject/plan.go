@@ -0,0 +1,121 @@
+package atlasproject
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+// unplannedProjectSections are the sections of the project the reconciliation may change in Atlas which are not
+// planned, so they're reported along with the plan
+var unplannedProjectSections = []string{
+	"X.509 authentication",
+	"private endpoints",
+	"cloud provider access",
+	"network peering",
+	"integrations",
+	"maintenance window",
+	"encryption at rest",
+	"auditing",
+	"project settings",
+	"custom roles",
+	"teams",
+}
+
+// planProject computes the changes the reconciliation would apply to the Atlas project without applying them.
+// Only the project itself, its IP Access List and its alert configurations are planned, the other sections the
+// reconciliation may change are returned as unplanned.
+// An empty plan means the planned sections of the project in Atlas already match the spec.
+func (r *AtlasProjectReconciler) planProject(ctx *workflow.Context, project *mdbv1.AtlasProject) (string, []string, error) {
+	if !project.GetDeletionTimestamp().IsZero() {
+		return fmt.Sprintf("project %q would be deleted from Atlas", project.Spec.Name), nil, nil
+	}
+
+	p, _, err := ctx.Client.Projects.GetOneProjectByName(ctx.Context, project.Spec.Name)
+	if err != nil {
+		var apiError *mongodbatlas.ErrorResponse
+		if errors.As(err, &apiError) && (apiError.ErrorCode == atlas.NotInGroup || apiError.ErrorCode == atlas.ResourceNotFound) {
+			return fmt.Sprintf("project %q would be created in Atlas", project.Spec.Name), unplannedProjectSections, nil
+		}
+		return "", nil, err
+	}
+
+	changes, err := planIPAccessList(ctx, p.ID, project)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if project.Spec.AlertConfigurationSyncEnabled {
+		alertConfigs := project.Spec.DeepCopy().AlertConfigurations
+		if err = r.readAlertConfigurationsSecretsData(project, alertConfigs); err != nil {
+			return "", nil, err
+		}
+
+		alertChanges, err := planAlertConfigurations(ctx, p.ID, alertConfigs)
+		if err != nil {
+			return "", nil, err
+		}
+		changes = append(changes, alertChanges...)
+	}
+
+	return strings.Join(changes, "\n"), unplannedProjectSections, nil
+}
+
+func planIPAccessList(ctx *workflow.Context, projectID string, project *mdbv1.AtlasProject) ([]string, error) {
+	list, _, err := ctx.Client.ProjectIPAccessList.List(ctx.Context, projectID, &mongodbatlas.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve IP Access list: %w", err)
+	}
+
+	current := map[string]struct{}{}
+	for _, item := range mapToOperatorSpec(list.Results) {
+		current[genIPAccessListKey(item)] = struct{}{}
+	}
+
+	desiredList, _ := filterActiveIPAccessLists(project.Spec.ProjectIPAccessList)
+	desired := map[string]struct{}{}
+	for _, item := range desiredList {
+		desired[genIPAccessListKey(item)] = struct{}{}
+	}
+
+	var changes []string
+	for key := range desired {
+		if _, ok := current[key]; !ok {
+			changes = append(changes, fmt.Sprintf("IP Access List entry %q would be created in Atlas", key))
+		}
+	}
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			changes = append(changes, fmt.Sprintf("IP Access List entry %q would be deleted from Atlas", key))
+		}
+	}
+	sort.Strings(changes)
+
+	return changes, nil
+}
+
+func planAlertConfigurations(ctx *workflow.Context, projectID string, alertSpec []mdbv1.AlertConfiguration) ([]string, error) {
+	existedAlertConfigs, _, err := ctx.Client.AlertConfigurations.List(ctx.Context, projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert configurations: %w", err)
+	}
+
+	diff := sortAlertConfigs(ctx.Log, alertSpec, existedAlertConfigs)
+
+	changes := make([]string, 0, len(diff.Create)+len(diff.Delete))
+	for _, alertConfig := range diff.Create {
+		changes = append(changes, fmt.Sprintf("alert configuration for event %q would be created in Atlas", alertConfig.EventTypeName))
+	}
+	for _, id := range diff.Delete {
+		changes = append(changes, fmt.Sprintf("alert configuration %q would be deleted from Atlas", id))
+	}
+
+	return changes, nil
+}
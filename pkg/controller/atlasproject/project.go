@@ -1,7 +1,6 @@
 package atlasproject
 
 import (
-	"context"
 	"errors"
 
 	"go.mongodb.org/atlas/mongodbatlas"
@@ -14,7 +13,7 @@ import (
 // ensureProjectExists creates the project if it doesn't exist yet. Returns the project ID
 func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, project *mdbv1.AtlasProject) (string, workflow.Result) {
 	// Try to find the project
-	p, _, err := ctx.Client.Projects.GetOneProjectByName(context.Background(), project.Spec.Name)
+	p, _, err := ctx.Client.Projects.GetOneProjectByName(ctx.Context, project.Spec.Name)
 	if err != nil {
 		ctx.Log.Infow("Error", "err", err.Error())
 		var apiError *mongodbatlas.ErrorResponse
@@ -25,12 +24,12 @@ func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, proj
 				Name:                      project.Spec.Name,
 				WithDefaultAlertsSettings: &project.Spec.WithDefaultAlertsSettings,
 			}
-			if p, _, err = ctx.Client.Projects.Create(context.Background(), p, &mongodbatlas.CreateProjectOptions{}); err != nil {
-				return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error())
+			if p, _, err = ctx.Client.Projects.Create(ctx.Context, p, &mongodbatlas.CreateProjectOptions{}); err != nil {
+				return "", workflow.TerminateWithError(workflow.ProjectNotCreatedInAtlas, err)
 			}
 			ctx.Log.Infow("Created Atlas Project", "name", project.Spec.Name, "id", p.ID)
 		} else {
-			return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error())
+			return "", workflow.TerminateWithError(workflow.ProjectNotCreatedInAtlas, err)
 		}
 	}
 
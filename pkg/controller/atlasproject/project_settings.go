@@ -77,12 +77,12 @@ func patchSettings(ctx *workflow.Context, projectID string, spec *v1.ProjectSett
 		return err
 	}
 
-	_, _, err = ctx.Client.Projects.UpdateProjectSettings(context.Background(), projectID, specAsAtlas)
+	_, _, err = ctx.Client.Projects.UpdateProjectSettings(ctx.Context, projectID, specAsAtlas)
 	return err
 }
 
 func fetchSettings(ctx *workflow.Context, projectID string) (*v1.ProjectSettings, error) {
-	data, _, err := ctx.Client.Projects.GetProjectSettings(context.Background(), projectID)
+	data, _, err := ctx.Client.Projects.GetProjectSettings(ctx.Context, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -22,13 +22,17 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
 )
 
 func (r *AtlasProjectReconciler) teamReconcile(
 	team *v1.AtlasTeam,
 	connection atlas.Connection,
 ) reconcile.Func {
-	return func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return func(ctx context.Context, req reconcile.Request) (reconcileResult reconcile.Result, _ error) {
+		ctx, span := tracing.Start(ctx, "AtlasTeam.Reconcile", tracing.ResourceAttributes(req.NamespacedName)...)
+		defer span.End()
+
 		log := r.Log.With("atlasteam", req.NamespacedName)
 
 		result := customresource.PrepareResource(r.Client, req, team, log)
@@ -41,13 +45,17 @@ func (r *AtlasProjectReconciler) teamReconcile(
 			return workflow.OK().ReconcileResult(), nil
 		}
 
-		teamCtx, err := createTeamContextFromParent(team, r.Client, connection, r.AtlasDomain, log)
+		teamCtx, err := createTeamContextFromParent(team, r.Client, r.AtlasClients, connection, r.Settings.AtlasDomain(), log)
 		if err != nil {
 			teamCtx.SetConditionFalse(status.ReadyType)
 			return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
 		}
+		teamCtx.Context = ctx
 
-		defer statushandler.Update(teamCtx, r.Client, r.EventRecorder, team)
+		defer func() {
+			reconcileResult = teamCtx.ApplyBackoff(reconcileResult)
+			statushandler.Update(teamCtx, r.Client, r.EventRecorder, team)
+		}()
 
 		resourceVersionIsValid := customresource.ValidateResourceVersion(teamCtx, team, r.Log)
 		if !resourceVersionIsValid.IsOk() {
@@ -57,7 +65,7 @@ func (r *AtlasProjectReconciler) teamReconcile(
 
 		log.Infow("-> Starting AtlasTeam reconciliation", "spec", team.Spec)
 
-		owner, err := customresource.IsOwner(team, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, teamsManagedByAtlas(ctx, teamCtx.Client, connection.OrgID))
+		owner, err := customresource.IsOwner(team, r.Settings.ObjectDeletionProtection(), customresource.IsResourceManagedByOperator, teamsManagedByAtlas(ctx, teamCtx.Client, connection.OrgID))
 		if err != nil {
 			result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
 			teamCtx.SetConditionFromResult(status.ReadyType, result)
@@ -114,7 +122,7 @@ func (r *AtlasProjectReconciler) teamReconcile(
 		if !team.GetDeletionTimestamp().IsZero() {
 			if customresource.HaveFinalizer(team, customresource.FinalizerLabel) {
 				log.Warnf("team %s is assigned to a project. Remove it from all projects before delete", team.Name)
-			} else if customresource.IsResourceProtected(team, r.ObjectDeletionProtection) {
+			} else if customresource.IsResourceProtected(team, r.Settings.ObjectDeletionProtection()) {
 				log.Info("Not removing Team from Atlas as per configuration")
 				return workflow.OK().ReconcileResult(), nil
 			} else {
@@ -145,13 +153,14 @@ func (r *AtlasProjectReconciler) teamReconcile(
 func createTeamContextFromParent(
 	team *v1.AtlasTeam,
 	kubeClient client.Client,
+	atlasClients *atlas.ClientRegistry,
 	atlasConnection atlas.Connection,
 	atlasDomain string,
 	logger *zap.SugaredLogger,
 ) (*workflow.Context, error) {
 	teamCtx := customresource.MarkReconciliationStarted(kubeClient, team, logger)
 	teamCtx.Connection = atlasConnection
-	atlasClient, err := atlas.Client(atlasDomain, atlasConnection, logger)
+	atlasClient, err := atlasClients.Client(atlasDomain, atlasConnection, logger)
 	if err != nil {
 		return nil, err
 	}
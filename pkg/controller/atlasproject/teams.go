@@ -20,7 +20,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/statushandler"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 )
@@ -32,12 +31,6 @@ type TeamDataContainer struct {
 }
 
 func (r *AtlasProjectReconciler) ensureAssignedTeams(ctx context.Context, workflowCtx *workflow.Context, project *v1.AtlasProject, protected bool) workflow.Result {
-	resourcesToWatch := make([]watch.WatchedObject, 0, len(project.Spec.Teams))
-	defer func() {
-		workflowCtx.AddResourcesToWatch(resourcesToWatch...)
-		r.Log.Debugf("watching team resources: %v\r\n", r.WatchedResources)
-	}()
-
 	teamsToAssign := map[string]*v1.Team{}
 	for _, entry := range project.Spec.Teams {
 		assignedTeam := entry
@@ -55,7 +48,7 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(ctx context.Context, workfl
 		team := &v1.AtlasTeam{}
 		teamReconciler := r.teamReconcile(team, workflowCtx.Connection)
 		_, err := teamReconciler(
-			context.Background(),
+			ctx,
 			controllerruntime.Request{NamespacedName: types.NamespacedName{Name: assignedTeam.TeamRef.Name, Namespace: assignedTeam.TeamRef.Namespace}},
 		)
 		if err != nil {
@@ -63,11 +56,6 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(ctx context.Context, workfl
 			continue
 		}
 
-		resourcesToWatch = append(
-			resourcesToWatch,
-			watch.WatchedObject{ResourceKind: team.Kind, Resource: types.NamespacedName{Name: assignedTeam.TeamRef.Name, Namespace: assignedTeam.TeamRef.Namespace}},
-		)
-
 		teamsToAssign[team.Status.ID] = &assignedTeam
 	}
 
@@ -107,7 +95,7 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(ctx context.Context, workfl
 
 func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projectID string, project *v1.AtlasProject, teamsToAssign map[string]*v1.Team) error {
 	ctx.Log.Debug("fetching assigned teams from atlas")
-	atlasAssignedTeams, _, err := ctx.Client.Projects.GetProjectTeamsAssigned(context.Background(), projectID)
+	atlasAssignedTeams, _, err := ctx.Client.Projects.GetProjectTeamsAssigned(ctx.Context, projectID)
 	if err != nil {
 		return err
 	}
@@ -118,7 +106,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 		currentProjectsStatus[projectTeam.ID] = projectTeam
 	}
 
-	defer statushandler.Update(ctx, r.Client, r.EventRecorder, project)
+	defer statushandler.UpdateIntermediate(ctx, r.Client, r.EventRecorder, project)
 
 	toDelete := make([]*mongodbatlas.Result, 0, len(atlasAssignedTeams.Results))
 	for _, atlasAssignedTeam := range atlasAssignedTeams.Results {
@@ -140,7 +128,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 		}
 
 		ctx.Log.Debugf("removing team %s from project for later update", atlasAssignedTeam.TeamID)
-		_, err = ctx.Client.Teams.RemoveTeamFromProject(context.Background(), projectID, atlasAssignedTeam.TeamID)
+		_, err = ctx.Client.Teams.RemoveTeamFromProject(ctx.Context, projectID, atlasAssignedTeam.TeamID)
 		if err != nil {
 			ctx.Log.Warnf("failed to remove team %s from project: %s", atlasAssignedTeam.TeamID, err.Error())
 		}
@@ -148,7 +136,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 
 	for _, atlasAssignedTeam := range toDelete {
 		ctx.Log.Debugf("removing team %s from project", atlasAssignedTeam.TeamID)
-		_, err = ctx.Client.Teams.RemoveTeamFromProject(context.Background(), projectID, atlasAssignedTeam.TeamID)
+		_, err = ctx.Client.Teams.RemoveTeamFromProject(ctx.Context, projectID, atlasAssignedTeam.TeamID)
 		if err != nil {
 			ctx.Log.Warnf("failed to remove team %s from project: %s", atlasAssignedTeam.TeamID, err.Error())
 		}
@@ -181,7 +169,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 			}
 		}
 
-		_, _, err = ctx.Client.Projects.AddTeamsToProject(context.Background(), projectID, projectTeams)
+		_, _, err = ctx.Client.Projects.AddTeamsToProject(ctx.Context, projectID, projectTeams)
 		if err != nil {
 			return err
 		}
@@ -199,7 +187,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 func (r *AtlasProjectReconciler) updateTeamState(ctx *workflow.Context, project *v1.AtlasProject, teamRef *common.ResourceRefNamespaced, isRemoval bool) error {
 	team := &v1.AtlasTeam{}
 	objKey := kube.ObjectKey(teamRef.Namespace, teamRef.Name)
-	err := r.Client.Get(context.Background(), objKey, team)
+	err := r.Client.Get(ctx.Context, objKey, team)
 	if err != nil {
 		return err
 	}
@@ -225,14 +213,14 @@ func (r *AtlasProjectReconciler) updateTeamState(ctx *workflow.Context, project
 	}
 
 	log := r.Log.With("atlasteam", teamRef)
-	teamCtx, err := createTeamContextFromParent(team, r.Client, ctx.Connection, r.AtlasDomain, log)
+	teamCtx, err := createTeamContextFromParent(team, r.Client, r.AtlasClients, ctx.Connection, r.Settings.AtlasDomain(), log)
 	if err != nil {
 		return err
 	}
 
 	if len(assignedProjects) == 0 {
 		log.Debugf("team %s has no project associated to it. removing from atlas.", team.Spec.Name)
-		_, err = teamCtx.Client.Teams.RemoveTeamFromOrganization(context.Background(), teamCtx.Connection.OrgID, team.Status.ID)
+		_, err = teamCtx.Client.Teams.RemoveTeamFromOrganization(ctx.Context, teamCtx.Connection.OrgID, team.Status.ID)
 		if err != nil {
 			return err
 		}
@@ -241,7 +229,7 @@ func (r *AtlasProjectReconciler) updateTeamState(ctx *workflow.Context, project
 	}
 
 	teamCtx.EnsureStatusOption(status.AtlasTeamSetProjects(assignedProjects))
-	statushandler.Update(teamCtx, r.Client, r.EventRecorder, team)
+	statushandler.UpdateIntermediate(teamCtx, r.Client, r.EventRecorder, team)
 
 	return nil
 }
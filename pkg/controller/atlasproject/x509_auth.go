@@ -32,7 +32,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 
 	if authModes.CheckAuthMode(authmode.X509) && specCert == "" {
 		log.Infow("Disable x509 auth", "projectID", projectID)
-		_, err := ctx.Client.X509AuthDBUsers.DisableCustomerX509(context.Background(), projectID)
+		_, err := ctx.Client.X509AuthDBUsers.DisableCustomerX509(ctx.Context, projectID)
 		if err != nil {
 			return authModes, workflow.Terminate(workflow.Internal, err.Error())
 		}
@@ -40,7 +40,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 		return authModes, workflow.OK()
 	}
 
-	customer, _, err := ctx.Client.X509AuthDBUsers.GetCurrentX509Conf(context.Background(), projectID)
+	customer, _, err := ctx.Client.X509AuthDBUsers.GetCurrentX509Conf(ctx.Context, projectID)
 	if err != nil {
 		return authModes, workflow.Terminate(workflow.Internal, err.Error())
 	}
@@ -52,7 +52,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 		log.Infow("Saving new x509 cert", "projectID", projectID)
 		log.Debugw("New customer", "conf", conf)
 
-		_, _, err := ctx.Client.X509AuthDBUsers.SaveConfiguration(context.Background(), projectID, &conf)
+		_, _, err := ctx.Client.X509AuthDBUsers.SaveConfiguration(ctx.Context, projectID, &conf)
 		if err != nil {
 			return authModes, workflow.Terminate(workflow.Internal, err.Error())
 		}
@@ -0,0 +1,57 @@
+package connectionsecret
+
+import (
+	"net"
+)
+
+// The keys of the connection Secret defined by the Service Binding specification, so that the Secret can be consumed
+// as the Provisioned Service by the ServiceBinding controllers and the frameworks like Spring Cloud Bindings.
+// See https://servicebinding.io/spec/core/1.0.0/#well-known-secret-entries
+const (
+	bindingTypeKey     = "type"
+	bindingProviderKey = "provider"
+	bindingHostKey     = "host"
+	bindingPortKey     = "port"
+	bindingDatabaseKey = "database"
+	bindingURIKey      = "uri"
+
+	BindingType     = "mongodb"
+	BindingProvider = "atlas"
+
+	defaultAuthDatabase = "admin"
+)
+
+// fillBinding adds the Service Binding keys to the Secret data. The host and the port are the ones of the first member
+// of the deployment, or the SRV host if the members are not known. The URI is the SRV connection string if available.
+func fillBinding(secretData map[string][]byte, data TemplateData) {
+	secretData[bindingTypeKey] = []byte(BindingType)
+	secretData[bindingProviderKey] = []byte(BindingProvider)
+	secretData[userNameKey] = []byte(data.Username)
+	secretData[passwordKey] = []byte(data.Password)
+
+	database := data.AuthDatabase
+	if database == "" {
+		database = defaultAuthDatabase
+	}
+	secretData[bindingDatabaseKey] = []byte(database)
+
+	uri := data.ConnectionStringStandardSrv
+	if uri == "" {
+		uri = data.ConnectionStringStandard
+	}
+	secretData[bindingURIKey] = []byte(uri)
+
+	switch {
+	case len(data.Hosts) > 0:
+		host, port, err := net.SplitHostPort(data.Hosts[0])
+		if err != nil {
+			host, port = data.Hosts[0], ""
+		}
+		secretData[bindingHostKey] = []byte(host)
+		if port != "" {
+			secretData[bindingPortKey] = []byte(port)
+		}
+	case data.SrvHost != "":
+		secretData[bindingHostKey] = []byte(data.SrvHost)
+	}
+}
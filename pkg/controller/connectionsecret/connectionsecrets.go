@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/stringutil"
@@ -18,8 +19,11 @@ import (
 
 const ConnectionSecretsEnsuredEvent = "ConnectionSecretsEnsured"
 
-func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
-	advancedDeployments, _, err := ctx.Client.AdvancedClusters.List(context.Background(), project.ID(), &mongodbatlas.ListOptions{})
+// CreateOrUpdateConnectionSecrets ensures the connection Secrets of the user for all the deployments of the project.
+// The credentials of the user are read with the credentials reader, which may bypass the cache to see the Secrets
+// created during the same reconciliation.
+func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Client, credentials client.Reader, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, targets []string) workflow.Result {
+	advancedDeployments, _, err := ctx.Client.AdvancedClusters.List(ctx.Context, project.ID(), &mongodbatlas.ListOptions{})
 	if err != nil {
 		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
 	}
@@ -55,7 +59,7 @@ func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Cli
 	}
 
 	// ensure secrets for both deployments and advanced deployment.
-	if result := createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx, k8sClient, recorder, project, dbUser, deploymentSecrets); !result.IsOk() {
+	if result := createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx, k8sClient, credentials, recorder, project, dbUser, deploymentSecrets, targets); !result.IsOk() {
 		return result
 	}
 
@@ -68,9 +72,13 @@ type deploymentSecret struct {
 	connectionStrings *mongodbatlas.ConnectionStrings
 }
 
-func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, deploymentSecrets []deploymentSecret) workflow.Result {
+func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context, k8sClient client.Client, credentials client.Reader, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, deploymentSecrets []deploymentSecret, targets []string) workflow.Result {
 	requeue := false
 	secrets := make([]string, 0)
+	replicated := 0
+
+	// The previous targets are kept in the status until the stale Secrets are removed from them
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserConnectionSecretNamespacesOption(trackedNamespaces(dbUser, targets)))
 
 	for _, ds := range deploymentSecrets {
 		scopes := dbUser.GetScopes(mdbv1.DeploymentScopeType)
@@ -84,33 +92,50 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 			requeue = true
 			continue
 		}
-		password, err := dbUser.ReadPassword(k8sClient)
-		if err != nil {
-			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
-		}
 		data := ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    ds.connectionStrings.Standard,
-			SrvConnURL: ds.connectionStrings.StandardSrv,
+			ConnURL:        ds.connectionStrings.Standard,
+			SrvConnURL:     ds.connectionStrings.StandardSrv,
+			AuthDatabase:   dbUser.Spec.DatabaseName,
+			Template:       dbUser.Spec.ConnectionSecretTemplate,
+			ServiceBinding: dbUser.Spec.ServiceBinding,
+		}
+		if err := ReadCredentials(credentials, dbUser, &data); err != nil {
+			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
 		}
 		FillPrivateConnStrings(ds.connectionStrings, &data)
 
-		var secretName string
-		if secretName, err = Ensure(k8sClient, dbUser.Namespace, project.Spec.Name, project.ID(), ds.name, data); err != nil {
+		secretName, err := Ensure(k8sClient, dbUser.Namespace, project.Spec.Name, project.ID(), ds.name, data)
+		if err != nil {
 			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
 		}
 		secrets = append(secrets, secretName)
 		ctx.Log.Debugw("Ensured connection Secret up-to-date", "secretname", secretName)
+
+		for _, namespace := range targets {
+			if _, err = EnsureReplica(k8sClient, namespace, dbUser, project.Spec.Name, project.ID(), ds.name, data); err != nil {
+				return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, fmt.Sprintf("failed to replicate the connection Secret %s to the namespace %s: %s", secretName, namespace, err))
+			}
+			replicated++
+			ctx.Log.Debugw("Ensured replicated connection Secret up-to-date", "secretname", secretName, "namespace", namespace)
+		}
 	}
 
 	if len(secrets) > 0 {
 		recorder.Eventf(&dbUser, "Normal", ConnectionSecretsEnsuredEvent, "Connection Secrets were created/updated: %s", strings.Join(secrets, ", "))
 	}
+	if replicated > 0 {
+		recorder.Eventf(&dbUser, "Normal", ConnectionSecretsEnsuredEvent, "Connection Secrets were replicated to the namespaces: %s", strings.Join(targets, ", "))
+	}
+	bindingSecret := ""
+	if dbUser.Spec.ServiceBinding {
+		bindingSecret = BindingSecret(secrets)
+	}
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserBindingOption(bindingSecret))
 
-	if err := cleanupStaleSecrets(ctx, k8sClient, project.ID(), dbUser); err != nil {
+	if err := cleanupStaleSecrets(ctx, k8sClient, project.ID(), dbUser, targets); err != nil {
 		return workflow.Terminate(workflow.DatabaseUserStaleConnectionSecrets, err.Error())
 	}
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserConnectionSecretNamespacesOption(targets))
 
 	if requeue {
 		return workflow.InProgress(workflow.DatabaseUserConnectionSecretsNotCreated, "Waiting for deployments to get created/updated")
@@ -118,8 +143,20 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 	return workflow.OK()
 }
 
-func cleanupStaleSecrets(ctx *workflow.Context, k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser) error {
-	if err := removeStaleByScope(ctx, k8sClient, projectID, user); err != nil {
+// BindingSecret returns the connection Secret exposed as the Provisioned Service binding. Empty if there are multiple
+// Secrets as the binding is ambiguous then.
+func BindingSecret(secrets []string) string {
+	if len(secrets) != 1 {
+		return ""
+	}
+	return secrets[0]
+}
+
+func cleanupStaleSecrets(ctx *workflow.Context, k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser, targets []string) error {
+	if err := removeStaleByScope(ctx, k8sClient, projectID, user, targets); err != nil {
+		return err
+	}
+	if err := removeStaleByTargets(k8sClient, projectID, user, targets); err != nil {
 		return err
 	}
 	// Performing the cleanup of old secrets only if the username has changed
@@ -131,7 +168,7 @@ func cleanupStaleSecrets(ctx *workflow.Context, k8sClient client.Client, project
 }
 
 // removeStaleByScope removes the secrets that are not relevant due to changes to 'scopes' field for the AtlasDatabaseUser.
-func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser) error {
+func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser, targets []string) error {
 	scopes := user.GetScopes(mdbv1.DeploymentScopeType)
 	if len(scopes) == 0 {
 		return nil
@@ -140,6 +177,13 @@ func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectI
 	if err != nil {
 		return err
 	}
+	for _, namespace := range targets {
+		replicas, err := listReplicas(k8sClient, namespace, projectID, user.Spec.Username, user)
+		if err != nil {
+			return err
+		}
+		secrets = append(secrets, replicas...)
+	}
 	for i, s := range secrets {
 		deployment, ok := s.Labels[ClusterLabelKey]
 		if !ok {
@@ -149,22 +193,30 @@ func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectI
 			if err = k8sClient.Delete(context.Background(), &secrets[i]); err != nil {
 				return err
 			}
-			ctx.Log.Debugw("Removed connection Secret as it's not referenced by the AtlasDatabaseUser anymore", "secretname", s.Name)
+			ctx.Log.Debugw("Removed connection Secret as it's not referenced by the AtlasDatabaseUser anymore", "secretname", s.Name, "namespace", s.Namespace)
 		}
 	}
 	return nil
 }
 
-// RemoveStaleSecretsByUserName removes the stale secrets when the database user name changes (as it's used as a part of Secret name)
+// RemoveStaleSecretsByUserName removes the stale secrets when the database user name changes (as it's used as a part of Secret name).
+// The Secrets replicated to the namespaces recorded in the status of the user are removed as well.
 func RemoveStaleSecretsByUserName(k8sClient client.Client, projectID, userName string, user mdbv1.AtlasDatabaseUser, log *zap.SugaredLogger) error {
 	secrets, err := ListByUserName(k8sClient, user.Namespace, projectID, userName)
 	if err != nil {
 		return err
 	}
+	for _, namespace := range user.Status.ConnectionSecretNamespaces {
+		replicas, err := listReplicas(k8sClient, namespace, projectID, userName, user)
+		if err != nil {
+			return err
+		}
+		secrets = append(secrets, replicas...)
+	}
 	var lastError error
 	removed := 0
 	for i := range secrets {
-		if err = k8sClient.Delete(context.Background(), &secrets[i]); err != nil {
+		if err := k8sClient.Delete(context.Background(), &secrets[i]); err != nil {
 			log.Errorf("Failed to remove connection Secret: %v", err)
 			lastError = err
 		} else {
@@ -196,7 +248,7 @@ func FillPrivateConnStrings(connStrings *mongodbatlas.ConnectionStrings, data *C
 }
 
 func GetAllServerless(ctx *workflow.Context, projectID string) ([]*mongodbatlas.Cluster, error) {
-	serverless, _, err := ctx.Client.ServerlessInstances.List(context.Background(), projectID, nil)
+	serverless, _, err := ctx.Client.ServerlessInstances.List(ctx.Context, projectID, nil)
 	if err != nil {
 		if !IsCloudGovDomain(ctx) {
 			return nil, fmt.Errorf("error getting serverless: %w", err)
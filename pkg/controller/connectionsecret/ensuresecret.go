@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 )
 
@@ -34,6 +35,16 @@ type ConnectionData struct {
 	ConnURL         string
 	SrvConnURL      string
 	PrivateConnURLs []PrivateLinkConnURLs
+	// AuthDatabase is the database the user authenticates against
+	AuthDatabase string
+	// Hosts are the 'host:port' of the members, read from the ConnURL if empty
+	Hosts []string
+	// Template renders the custom keys of the Secret
+	Template *mdbv1.ConnectionSecretTemplate
+	// ServiceBinding adds the keys defined by the Service Binding specification
+	ServiceBinding bool
+	// X509 is the certificate the user authenticates with instead of the password
+	X509 *X509Certificate
 }
 
 type PrivateLinkConnURLs struct {
@@ -45,6 +56,16 @@ type PrivateLinkConnURLs struct {
 // Ensure creates or updates the connection Secret for the specific cluster and db user. Returns the name of the Secret
 // created.
 func Ensure(client client.Client, namespace, projectName, projectID, clusterName string, data ConnectionData) (string, error) {
+	return ensure(client, namespace, projectName, projectID, clusterName, data, "")
+}
+
+// EnsureReplica creates or updates the connection Secret of the user replicated to the target namespace. The existing
+// Secret is updated only if it's replicated from the same user.
+func EnsureReplica(client client.Client, namespace string, user mdbv1.AtlasDatabaseUser, projectName, projectID, clusterName string, data ConnectionData) (string, error) {
+	return ensure(client, namespace, projectName, projectID, clusterName, data, replicaSource(user))
+}
+
+func ensure(client client.Client, namespace, projectName, projectID, clusterName string, data ConnectionData, source string) (string, error) {
 	var getError error
 	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
 		Name:      formatSecretName(projectName, clusterName, data.DBUserName),
@@ -53,9 +74,18 @@ func Ensure(client client.Client, namespace, projectName, projectID, clusterName
 	if getError = client.Get(context.Background(), kube.ObjectKeyFromObject(s), s); getError != nil && !apiErrors.IsNotFound(getError) {
 		return "", getError
 	}
-	if err := fillSecret(s, projectID, clusterName, data); err != nil {
+	if source != "" && getError == nil && s.Annotations[SourceAnnotation] != source {
+		return "", fmt.Errorf("the Secret %s already exists and is not replicated from the AtlasDatabaseUser %s", s.Name, source)
+	}
+	if err := fillSecret(s, projectName, projectID, clusterName, data); err != nil {
 		return "", err
 	}
+	if source != "" {
+		if s.Annotations == nil {
+			s.Annotations = map[string]string{}
+		}
+		s.Annotations[SourceAnnotation] = source
+	}
 	if getError != nil {
 		// Creating
 		return s.Name, client.Create(context.Background(), s)
@@ -64,22 +94,29 @@ func Ensure(client client.Client, namespace, projectName, projectID, clusterName
 	return s.Name, client.Update(context.Background(), s)
 }
 
-func fillSecret(secret *corev1.Secret, projectID string, clusterName string, data ConnectionData) error {
+func fillSecret(secret *corev1.Secret, projectName, projectID string, clusterName string, data ConnectionData) error {
+	addAuth := func(connURL string) (string, error) {
+		if data.X509 != nil {
+			return AddX509ToConnectionURL(connURL)
+		}
+		return AddCredentialsToConnectionURL(connURL, data.DBUserName, data.Password)
+	}
+
 	var err error
-	if data.ConnURL, err = AddCredentialsToConnectionURL(data.ConnURL, data.DBUserName, data.Password); err != nil {
+	if data.ConnURL, err = addAuth(data.ConnURL); err != nil {
 		return err
 	}
-	if data.SrvConnURL, err = AddCredentialsToConnectionURL(data.SrvConnURL, data.DBUserName, data.Password); err != nil {
+	if data.SrvConnURL, err = addAuth(data.SrvConnURL); err != nil {
 		return err
 	}
 	for idx, privateConn := range data.PrivateConnURLs {
-		if data.PrivateConnURLs[idx].PvtConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtConnURL, err = addAuth(privateConn.PvtConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtSrvConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = addAuth(privateConn.PvtSrvConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtShardConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtShardConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtShardConnURL, err = addAuth(privateConn.PvtShardConnURL); err != nil {
 			return err
 		}
 	}
@@ -106,6 +143,34 @@ func fillSecret(secret *corev1.Secret, projectID string, clusterName string, dat
 		secret.Data[privateShardKey+suffix] = []byte(privateConn.PvtShardConnURL)
 	}
 
+	templateData := newTemplateData(projectName, projectID, clusterName, data)
+	if data.ServiceBinding {
+		fillBinding(secret.Data, templateData)
+	}
+
+	if data.X509 != nil {
+		// The users authenticating with the certificate don't have a password
+		delete(secret.Data, passwordKey)
+		secret.Data[corev1.TLSCertKey] = data.X509.Certificate
+		secret.Data[corev1.TLSPrivateKeyKey] = data.X509.PrivateKey
+		if len(data.X509.CA) > 0 {
+			secret.Data[caKey] = data.X509.CA
+		}
+	}
+
+	if data.Template == nil {
+		return nil
+	}
+	rendered, err := renderTemplate(data.Template, templateData)
+	if err != nil {
+		return err
+	}
+	if data.Template.SkipDefaultKeys {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range rendered {
+		secret.Data[key] = value
+	}
 	return nil
 }
 
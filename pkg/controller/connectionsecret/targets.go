@@ -0,0 +1,232 @@
+package connectionsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/stringutil"
+)
+
+const (
+	// AllowedSourcesAnnotation is the annotation of the target namespace listing the comma-separated namespaces of the
+	// AtlasDatabaseUsers allowed to replicate their connection Secrets to it, '*' allows all of them
+	AllowedSourcesAnnotation = "atlas.mongodb.com/connection-secret-sources"
+	// SourceAnnotation is the annotation of the replicated connection Secret referencing the AtlasDatabaseUser
+	// ('<namespace>/<name>') it's replicated from. The Secrets without it are never updated or removed by the replication.
+	SourceAnnotation = "atlas.mongodb.com/connection-secret-source"
+)
+
+// ErrInvalidTargets is returned if the user targets the namespaces it can't replicate the connection Secrets to
+var ErrInvalidTargets = errors.New("invalid connection Secret targets")
+
+// TargetNamespaces returns the sorted namespaces the connection Secrets of the user are replicated to. The namespace
+// of the user itself is never included as the Secrets are always created there. The targets must be watched by the
+// operator and allow the namespace of the user with the AllowedSourcesAnnotation, otherwise the error wraps
+// ErrInvalidTargets. The watched namespaces are all the namespaces if empty or containing "".
+func TargetNamespaces(ctx context.Context, k8sClient client.Client, user mdbv1.AtlasDatabaseUser, watchedNamespaces map[string]bool) ([]string, error) {
+	targets := user.Spec.ConnectionSecretTargets
+	if targets == nil {
+		return nil, nil
+	}
+
+	var selector labels.Selector
+	if targets.NamespaceSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(targets.NamespaceSelector); err != nil {
+			return nil, fmt.Errorf("%w: invalid namespace selector: %s", ErrInvalidTargets, err)
+		}
+	}
+
+	var namespaces, notWatched, notAllowed []string
+	add := func(ns *corev1.Namespace) {
+		if ns.Name == user.Namespace || stringutil.Contains(namespaces, ns.Name) || ns.Status.Phase == corev1.NamespaceTerminating {
+			return
+		}
+		if !AllowsSource(ns, user.Namespace) {
+			notAllowed = append(notAllowed, ns.Name)
+			return
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+
+	for _, name := range targets.Namespaces {
+		if name == "" || name == user.Namespace {
+			continue
+		}
+		if !isWatched(watchedNamespaces, name) {
+			notWatched = append(notWatched, name)
+			continue
+		}
+		ns := &corev1.Namespace{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: the namespace %s doesn't exist", ErrInvalidTargets, name)
+			}
+			return nil, fmt.Errorf("failed to read the namespace %s: %w", name, err)
+		}
+		add(ns)
+	}
+
+	if selector != nil {
+		selected, err := selectNamespaces(ctx, k8sClient, selector, watchedNamespaces, user.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for i := range selected {
+			add(&selected[i])
+		}
+	}
+
+	if len(notWatched) > 0 {
+		return nil, fmt.Errorf("%w: the namespaces %s are not watched by the operator", ErrInvalidTargets, strings.Join(notWatched, ", "))
+	}
+	if len(notAllowed) > 0 {
+		return nil, fmt.Errorf("%w: the namespaces %s don't allow the connection Secrets from the namespace %s, see the %s annotation",
+			ErrInvalidTargets, strings.Join(notAllowed, ", "), user.Namespace, AllowedSourcesAnnotation)
+	}
+
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// selectNamespaces returns the watched namespaces matching the selector. The source namespace is never read as the
+// operator watching only it may not be allowed to read the namespaces.
+func selectNamespaces(ctx context.Context, k8sClient client.Client, selector labels.Selector, watchedNamespaces map[string]bool, sourceNamespace string) ([]corev1.Namespace, error) {
+	if isWatched(watchedNamespaces, "") {
+		list := corev1.NamespaceList{}
+		if err := k8sClient.List(ctx, &list, &client.ListOptions{LabelSelector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list the namespaces: %w", err)
+		}
+		return list.Items, nil
+	}
+
+	var result []corev1.Namespace
+	for name := range watchedNamespaces {
+		if name == sourceNamespace {
+			continue
+		}
+		ns := corev1.Namespace{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, &ns); err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read the namespace %s: %w", name, err)
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			result = append(result, ns)
+		}
+	}
+	return result, nil
+}
+
+// WatchesOtherNamespaces returns true if the operator watches more than a single namespace, so that the connection
+// Secrets may be replicated across the namespaces
+func WatchesOtherNamespaces(watchedNamespaces map[string]bool) bool {
+	return isWatched(watchedNamespaces, "") || len(watchedNamespaces) > 1
+}
+
+func isWatched(watchedNamespaces map[string]bool, namespace string) bool {
+	return len(watchedNamespaces) == 0 || watchedNamespaces[""] || watchedNamespaces[namespace]
+}
+
+// AllowsSource returns true if the namespace allows the connection Secrets replicated from the source namespace
+func AllowsSource(ns *corev1.Namespace, sourceNamespace string) bool {
+	for _, allowed := range strings.Split(ns.Annotations[AllowedSourcesAnnotation], ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == sourceNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Targets returns true if the user may replicate its connection Secrets to the namespace: it's either targeted by
+// the spec or contains the Secrets replicated before.
+func Targets(user mdbv1.AtlasDatabaseUser, ns *corev1.Namespace) bool {
+	if ns.Name == user.Namespace {
+		return false
+	}
+	if stringutil.Contains(user.Status.ConnectionSecretNamespaces, ns.Name) {
+		return true
+	}
+	targets := user.Spec.ConnectionSecretTargets
+	if targets == nil {
+		return false
+	}
+	if stringutil.Contains(targets.Namespaces, ns.Name) {
+		return true
+	}
+	if targets.NamespaceSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(targets.NamespaceSelector)
+	return err == nil && selector.Matches(labels.Set(ns.Labels))
+}
+
+// replicaSource is the value of the SourceAnnotation of the Secrets replicated from the user
+func replicaSource(user mdbv1.AtlasDatabaseUser) string {
+	return kube.ObjectKeyFromObject(&user).String()
+}
+
+// listReplicas returns the connection Secrets of the user replicated to the namespace, the other Secrets are ignored
+func listReplicas(k8sClient client.Client, namespace, projectID, userName string, user mdbv1.AtlasDatabaseUser) ([]corev1.Secret, error) {
+	secrets, err := ListByUserName(k8sClient, namespace, projectID, userName)
+	if err != nil {
+		return nil, err
+	}
+	var replicas []corev1.Secret
+	for _, secret := range secrets {
+		if secret.Annotations[SourceAnnotation] == replicaSource(user) {
+			replicas = append(replicas, secret)
+		}
+	}
+	return replicas, nil
+}
+
+// removeStaleByTargets removes the connection Secrets from the namespaces the user doesn't target anymore.
+func removeStaleByTargets(k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser, targets []string) error {
+	for _, namespace := range user.Status.ConnectionSecretNamespaces {
+		if namespace == user.Namespace || stringutil.Contains(targets, namespace) {
+			continue
+		}
+		secrets, err := listReplicas(k8sClient, namespace, projectID, user.Spec.Username, user)
+		if err != nil {
+			return err
+		}
+		for i := range secrets {
+			if err = k8sClient.Delete(context.Background(), &secrets[i]); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// trackedNamespaces returns the namespaces that may contain the replicated connection Secrets: the ones recorded in the
+// status and the current targets.
+func trackedNamespaces(user mdbv1.AtlasDatabaseUser, targets []string) []string {
+	namespaces := make([]string, 0, len(user.Status.ConnectionSecretNamespaces)+len(targets))
+	for _, namespace := range user.Status.ConnectionSecretNamespaces {
+		if !stringutil.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	for _, namespace := range targets {
+		if !stringutil.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
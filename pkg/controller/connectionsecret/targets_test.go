@@ -0,0 +1,188 @@
+package connectionsecret
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+func targetsClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func testNamespace(name string, labels map[string]string, allowedSources string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	if allowedSources != "" {
+		ns.Annotations = map[string]string{AllowedSourcesAnnotation: allowedSources}
+	}
+	return ns
+}
+
+func TestTargetNamespaces(t *testing.T) {
+	k8sClient := targetsClient(
+		testNamespace("platform", map[string]string{"team": "true"}, ""),
+		testNamespace("team-a", map[string]string{"team": "true"}, "platform"),
+		testNamespace("team-b", map[string]string{"team": "true"}, "apps, platform"),
+		testNamespace("other", nil, "*"),
+		testNamespace("closed", nil, "apps"),
+	)
+	user := mdbv1.DefaultDBUser("platform", "user1", "project")
+
+	t.Run("No targets", func(t *testing.T) {
+		namespaces, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		require.NoError(t, err)
+		assert.Empty(t, namespaces)
+	})
+	t.Run("Explicit namespaces", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{Namespaces: []string{"team-b", "platform", "team-a", "team-b"}}
+
+		namespaces, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"team-a", "team-b"}, namespaces)
+	})
+	t.Run("Namespace selector", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{
+			Namespaces:        []string{"other"},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+		}
+
+		namespaces, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other", "team-a", "team-b"}, namespaces)
+	})
+	t.Run("Invalid selector", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{
+			NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}}},
+		}
+
+		_, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		assert.True(t, errors.Is(err, ErrInvalidTargets))
+		assert.ErrorContains(t, err, "invalid namespace selector")
+	})
+	t.Run("Namespace not allowing the source", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{Namespaces: []string{"team-a", "closed"}}
+
+		_, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		assert.True(t, errors.Is(err, ErrInvalidTargets))
+		assert.ErrorContains(t, err, "the namespaces closed don't allow the connection Secrets from the namespace platform")
+	})
+	t.Run("Namespace not watched", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{Namespaces: []string{"team-a", "team-b"}}
+
+		_, err := TargetNamespaces(context.Background(), k8sClient, *user, map[string]bool{"platform": true, "team-a": true})
+		assert.True(t, errors.Is(err, ErrInvalidTargets))
+		assert.ErrorContains(t, err, "the namespaces team-b are not watched by the operator")
+	})
+	t.Run("Selector limited to the watched namespaces", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+		}
+
+		namespaces, err := TargetNamespaces(context.Background(), k8sClient, *user, map[string]bool{"platform": true, "team-a": true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"team-a"}, namespaces)
+	})
+	t.Run("Missing namespace", func(t *testing.T) {
+		user := user.DeepCopy()
+		user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{Namespaces: []string{"missing"}}
+
+		_, err := TargetNamespaces(context.Background(), k8sClient, *user, nil)
+		assert.True(t, errors.Is(err, ErrInvalidTargets))
+		assert.ErrorContains(t, err, "the namespace missing doesn't exist")
+	})
+}
+
+func TestTargets(t *testing.T) {
+	user := mdbv1.DefaultDBUser("platform", "user1", "project")
+	user.Spec.ConnectionSecretTargets = &mdbv1.ConnectionSecretTargets{
+		Namespaces:        []string{"team-a"},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+	}
+	user.Status.ConnectionSecretNamespaces = []string{"team-c"}
+
+	assert.True(t, Targets(*user, testNamespace("team-a", nil, "")))
+	assert.True(t, Targets(*user, testNamespace("team-b", map[string]string{"team": "true"}, "")))
+	assert.True(t, Targets(*user, testNamespace("team-c", nil, "")))
+	assert.False(t, Targets(*user, testNamespace("other", nil, "")))
+	assert.False(t, Targets(*user, testNamespace("platform", map[string]string{"team": "true"}, "")))
+}
+
+func TestEnsureReplicaRefusesForeignSecret(t *testing.T) {
+	k8sClient := targetsClient()
+	projectID := "603e7bf38a94956835659ae5"
+	data := dataForSecret()
+	data.DBUserName = "user1"
+	_, err := Ensure(k8sClient, "team-a", "p1", projectID, "c1", data)
+	require.NoError(t, err)
+
+	user := mdbv1.DefaultDBUser("platform", "user1", "project")
+	_, err = EnsureReplica(k8sClient, "team-a", *user, "p1", projectID, "c1", data)
+	assert.ErrorContains(t, err, "is not replicated from the AtlasDatabaseUser platform/user1")
+
+	other := mdbv1.DefaultDBUser("apps", "user1", "project")
+	_, err = EnsureReplica(k8sClient, "team-b", *other, "p1", projectID, "c1", data)
+	require.NoError(t, err)
+	_, err = EnsureReplica(k8sClient, "team-b", *user, "p1", projectID, "c1", data)
+	assert.ErrorContains(t, err, "is not replicated from the AtlasDatabaseUser platform/user1")
+}
+
+func TestRemoveStaleByTargets(t *testing.T) {
+	k8sClient := targetsClient()
+	projectID := "603e7bf38a94956835659ae5"
+	user := mdbv1.DefaultDBUser("platform", "user1", "project")
+	data := dataForSecret()
+	data.DBUserName = "user1"
+	_, err := Ensure(k8sClient, "platform", "p1", projectID, "c1", data)
+	require.NoError(t, err)
+	for _, ns := range []string{"team-a", "team-b"} {
+		_, err = EnsureReplica(k8sClient, ns, *user, "p1", projectID, "c1", data)
+		require.NoError(t, err)
+	}
+	// The Secret in the target namespace not replicated from the user is never removed
+	_, err = Ensure(k8sClient, "team-c", "p1", projectID, "c1", data)
+	require.NoError(t, err)
+	user.Status.ConnectionSecretNamespaces = []string{"team-a", "team-b", "team-c"}
+
+	require.NoError(t, removeStaleByTargets(k8sClient, projectID, *user, []string{"team-a"}))
+
+	for ns, expected := range map[string]int{"platform": 1, "team-a": 1, "team-b": 0, "team-c": 1} {
+		secrets, err := ListByUserName(k8sClient, ns, projectID, "user1")
+		require.NoError(t, err)
+		assert.Len(t, secrets, expected, ns)
+	}
+
+	require.NoError(t, RemoveStaleSecretsByUserName(k8sClient, projectID, "user1", *user, zap.S()))
+
+	secrets, err := ListByUserName(k8sClient, "", projectID, "user1")
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "team-c", secrets[0].Namespace)
+}
+
+func TestTrackedNamespaces(t *testing.T) {
+	user := mdbv1.DefaultDBUser("platform", "user1", "project")
+	user.Status.ConnectionSecretNamespaces = []string{"team-c", "team-a"}
+
+	assert.Equal(t, []string{"team-a", "team-b", "team-c"}, trackedNamespaces(*user, []string{"team-b", "team-a"}))
+}
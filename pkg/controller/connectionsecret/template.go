@@ -0,0 +1,113 @@
+package connectionsecret
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// templateFuncs are the functions available in the connection Secret templates in addition to the built-in ones
+var templateFuncs = template.FuncMap{
+	"join":        strings.Join,
+	"pathEscape":  url.PathEscape,
+	"queryEscape": url.QueryEscape,
+}
+
+// TemplateData is the data the connection Secret templates are rendered with
+type TemplateData struct {
+	// Username and Password are the credentials of the database user
+	Username string
+	Password string
+	// AuthDatabase is the database the user authenticates against
+	AuthDatabase string
+	ProjectID    string
+	ProjectName  string
+	// DeploymentName is the name of the deployment or the data federation the Secret is created for
+	DeploymentName string
+	// Hosts are the 'host:port' of the deployment members
+	Hosts []string
+	// SrvHost is the host of the DNS seed list of the deployment
+	SrvHost string
+	// Options are the options of the standard connection string, e.g. 'ssl=true&authSource=admin'
+	Options string
+	// The connection strings include the credentials of the user
+	ConnectionStringStandard    string
+	ConnectionStringStandardSrv string
+	PrivateEndpoints            []PrivateLinkConnURLs
+}
+
+// ParseTemplate parses the templates of the keys of the connection Secret
+func ParseTemplate(secretTemplate *mdbv1.ConnectionSecretTemplate) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(secretTemplate.Data))
+	for _, key := range sortedKeys(secretTemplate.Data) {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid connection Secret key %q: %s", key, strings.Join(errs, ", "))
+		}
+		tmpl, err := template.New(key).Funcs(templateFuncs).Option("missingkey=error").Parse(secretTemplate.Data[key])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template of the connection Secret key %q: %w", key, err)
+		}
+		templates[key] = tmpl
+	}
+	return templates, nil
+}
+
+// renderTemplate renders the values of the keys of the connection Secret
+func renderTemplate(secretTemplate *mdbv1.ConnectionSecretTemplate, data TemplateData) (map[string][]byte, error) {
+	templates, err := ParseTemplate(secretTemplate)
+	if err != nil {
+		return nil, err
+	}
+	rendered := make(map[string][]byte, len(templates))
+	for key, tmpl := range templates {
+		value := bytes.Buffer{}
+		if err = tmpl.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("failed to render the connection Secret key %q: %w", key, err)
+		}
+		rendered[key] = value.Bytes()
+	}
+	return rendered, nil
+}
+
+// newTemplateData returns the data of the templates, the connection strings of the data must contain the credentials
+func newTemplateData(projectName, projectID, clusterName string, data ConnectionData) TemplateData {
+	templateData := TemplateData{
+		Username:                    data.DBUserName,
+		Password:                    data.Password,
+		AuthDatabase:                data.AuthDatabase,
+		ProjectID:                   projectID,
+		ProjectName:                 projectName,
+		DeploymentName:              clusterName,
+		Hosts:                       data.Hosts,
+		ConnectionStringStandard:    data.ConnURL,
+		ConnectionStringStandardSrv: data.SrvConnURL,
+		PrivateEndpoints:            data.PrivateConnURLs,
+	}
+	// the hosts are set explicitly if the standard connection string is not a single URL (e.g. for data federations)
+	if standard, err := url.Parse(data.ConnURL); err == nil && len(data.Hosts) == 0 {
+		if standard.Host != "" {
+			templateData.Hosts = strings.Split(standard.Host, ",")
+		}
+		templateData.Options = standard.RawQuery
+	}
+	if srv, err := url.Parse(data.SrvConnURL); err == nil {
+		templateData.SrvHost = srv.Host
+	}
+	return templateData
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,194 @@
+package connectionsecret
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+const (
+	// X509ManagedType is the 'x509Type' of the database users authenticating with the certificates issued by Atlas
+	X509ManagedType = "MANAGED"
+
+	caKey             = "ca.crt"
+	x509AuthMechanism = "MONGODB-X509"
+	x509AuthSource    = "$external"
+)
+
+// ErrInvalidX509Certificate is returned if the certificate or its private key can't be parsed or don't match
+var ErrInvalidX509Certificate = errors.New("invalid X.509 certificate")
+
+// X509Certificate is the client certificate issued by Atlas for a database user.
+type X509Certificate struct {
+	// Certificate is the PEM encoded leaf certificate
+	Certificate []byte
+	// PrivateKey is the PEM encoded private key of the certificate
+	PrivateKey []byte
+	// CA is the PEM encoded chain of the certificate, empty if Atlas doesn't return one
+	CA []byte
+
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// RenewAt returns the time after which the certificate is renewed: two thirds of its validity period.
+func (c X509Certificate) RenewAt() time.Time {
+	return c.NotBefore.Add(c.NotAfter.Sub(c.NotBefore) * 2 / 3)
+}
+
+// ParseX509Certificate parses the PEM returned by Atlas for a new user certificate. The first certificate is the leaf
+// one, the certificates following it (if any) are its chain.
+func ParseX509Certificate(data []byte) (*X509Certificate, error) {
+	result := &X509Certificate{}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE" && result.Certificate == nil:
+			leaf, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidX509Certificate, err)
+			}
+			result.Certificate = pem.EncodeToMemory(block)
+			result.NotBefore = leaf.NotBefore
+			result.NotAfter = leaf.NotAfter
+		case block.Type == "CERTIFICATE":
+			result.CA = append(result.CA, pem.EncodeToMemory(block)...)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			result.PrivateKey = pem.EncodeToMemory(block)
+		}
+	}
+
+	if result.Certificate == nil {
+		return nil, fmt.Errorf("%w: no PEM encoded certificate found", ErrInvalidX509Certificate)
+	}
+	if result.PrivateKey == nil {
+		return nil, fmt.Errorf("%w: no PEM encoded private key found", ErrInvalidX509Certificate)
+	}
+	if _, err := tls.X509KeyPair(result.Certificate, result.PrivateKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidX509Certificate, err)
+	}
+	return result, nil
+}
+
+// X509SecretName returns the name of the Secret keeping the certificate issued for the user.
+func X509SecretName(user mdbv1.AtlasDatabaseUser) string {
+	return kube.NormalizeIdentifier(user.Name + "-x509")
+}
+
+// ReadX509Certificate reads the certificate issued for the user. Returns nil if the user doesn't authenticate with an
+// Atlas managed certificate or the certificate hasn't been issued yet. The error wraps ErrInvalidX509Certificate if
+// the Secret keeping the certificate is invalid.
+func ReadX509Certificate(k8sClient client.Reader, user mdbv1.AtlasDatabaseUser) (*X509Certificate, error) {
+	if user.Spec.X509Type != X509ManagedType {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: user.Namespace, Name: X509SecretName(user)}, secret)
+	if apiErrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	data = append(data, secret.Data[corev1.TLSCertKey]...)
+	data = append(data, secret.Data[caKey]...)
+	data = append(data, secret.Data[corev1.TLSPrivateKeyKey]...)
+	cert, err := ParseX509Certificate(data)
+	if err != nil {
+		return nil, fmt.Errorf("secret %s is invalid: %w", secret.Name, err)
+	}
+	return cert, nil
+}
+
+// SaveX509Certificate creates or updates the Secret keeping the certificate issued for the user.
+func SaveX509Certificate(k8sClient client.Client, user mdbv1.AtlasDatabaseUser, cert *X509Certificate) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      X509SecretName(user),
+		Namespace: user.Namespace,
+	}}
+	getError := k8sClient.Get(context.Background(), kube.ObjectKeyFromObject(secret), secret)
+	if getError != nil && !apiErrors.IsNotFound(getError) {
+		return getError
+	}
+
+	// The label makes the Secret visible to the Operator cache
+	secret.Labels = map[string]string{TypeLabelKey: CredLabelVal}
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       cert.Certificate,
+		corev1.TLSPrivateKeyKey: cert.PrivateKey,
+	}
+	if len(cert.CA) > 0 {
+		secret.Data[caKey] = cert.CA
+	}
+
+	if getError != nil {
+		return k8sClient.Create(context.Background(), secret)
+	}
+	return k8sClient.Update(context.Background(), secret)
+}
+
+// RemoveX509Certificate removes the Secret keeping the certificate issued for the user if it exists.
+func RemoveX509Certificate(k8sClient client.Client, user mdbv1.AtlasDatabaseUser) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      X509SecretName(user),
+		Namespace: user.Namespace,
+	}}
+	return client.IgnoreNotFound(k8sClient.Delete(context.Background(), secret))
+}
+
+// ReadCredentials fills the credentials of the user in the connection data: either the password or the certificate
+// issued by Atlas.
+func ReadCredentials(k8sClient client.Reader, user mdbv1.AtlasDatabaseUser, data *ConnectionData) error {
+	data.DBUserName = user.Spec.Username
+	if user.Spec.X509Type != X509ManagedType {
+		password, err := user.ReadPassword(k8sClient)
+		if err != nil {
+			return err
+		}
+		data.Password = password
+		return nil
+	}
+
+	cert, err := ReadX509Certificate(k8sClient, user)
+	if err != nil {
+		return err
+	}
+	if cert == nil {
+		return fmt.Errorf("the X.509 certificate of the database user %s hasn't been issued yet", user.Spec.Username)
+	}
+	data.X509 = cert
+	return nil
+}
+
+// AddX509ToConnectionURL makes the connection URL authenticate with the client certificate instead of the password.
+func AddX509ToConnectionURL(connURL string) (string, error) {
+	if connURL == "" {
+		return "", nil
+	}
+	cs, err := url.Parse(connURL)
+	if err != nil {
+		return "", err
+	}
+	cs.User = nil
+	query := cs.Query()
+	query.Set("authMechanism", x509AuthMechanism)
+	query.Set("authSource", x509AuthSource)
+	cs.RawQuery = query.Encode()
+	return cs.String(), nil
+}
@@ -0,0 +1,151 @@
+package connectionsecret
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+// issueCertificate returns the PEM encoded client certificate, its private key and the certificate of the CA signing it.
+func issueCertificate(t *testing.T, notBefore, notAfter time.Time) (leaf, key, ca []byte) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Atlas CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	userKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	userTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "user1"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	userDER, err := x509.CreateCertificate(rand.Reader, userTemplate, caTemplate, &userKey.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(userKey)
+	require.NoError(t, err)
+
+	leaf = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: userDER})
+	key = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	ca = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	return leaf, key, ca
+}
+
+func TestParseX509Certificate(t *testing.T) {
+	notBefore := time.Now().Truncate(time.Second).UTC()
+	notAfter := notBefore.Add(90 * 24 * time.Hour)
+	leaf, key, ca := issueCertificate(t, notBefore, notAfter)
+
+	t.Run("Certificate with the chain", func(t *testing.T) {
+		cert, err := ParseX509Certificate(append(append(append([]byte{}, leaf...), key...), ca...))
+		require.NoError(t, err)
+		assert.Equal(t, leaf, cert.Certificate)
+		assert.Equal(t, key, cert.PrivateKey)
+		assert.Equal(t, ca, cert.CA)
+		assert.Equal(t, notAfter, cert.NotAfter)
+		assert.Equal(t, notBefore.Add(60*24*time.Hour), cert.RenewAt())
+	})
+	t.Run("Certificate without the chain", func(t *testing.T) {
+		cert, err := ParseX509Certificate(append(append([]byte{}, key...), leaf...))
+		require.NoError(t, err)
+		assert.Equal(t, leaf, cert.Certificate)
+		assert.Empty(t, cert.CA)
+	})
+	t.Run("Missing private key", func(t *testing.T) {
+		_, err := ParseX509Certificate(leaf)
+		assert.ErrorContains(t, err, "no PEM encoded private key found")
+	})
+	t.Run("Mismatching private key", func(t *testing.T) {
+		_, otherKey, _ := issueCertificate(t, notBefore, notAfter)
+		_, err := ParseX509Certificate(append(append([]byte{}, leaf...), otherKey...))
+		assert.ErrorContains(t, err, "invalid X.509 certificate")
+	})
+}
+
+func TestX509CertificateSecret(t *testing.T) {
+	k8sClient := targetsClient()
+	user := mdbv1.DefaultDBUser("ns", "user1", "project")
+	user.Spec.X509Type = X509ManagedType
+
+	cert, err := ReadX509Certificate(k8sClient, *user)
+	require.NoError(t, err)
+	assert.Nil(t, cert)
+
+	leaf, key, ca := issueCertificate(t, time.Now(), time.Now().Add(time.Hour))
+	issued, err := ParseX509Certificate(append(append(append([]byte{}, leaf...), ca...), key...))
+	require.NoError(t, err)
+	require.NoError(t, SaveX509Certificate(k8sClient, *user, issued))
+	require.NoError(t, SaveX509Certificate(k8sClient, *user, issued))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(), kube.ObjectKey("ns", "user1-x509"), secret))
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	assert.Equal(t, CredLabelVal, secret.Labels[TypeLabelKey])
+
+	cert, err = ReadX509Certificate(k8sClient, *user)
+	require.NoError(t, err)
+	assert.Equal(t, issued, cert)
+
+	data := ConnectionData{}
+	require.NoError(t, ReadCredentials(k8sClient, *user, &data))
+	assert.Equal(t, "user1", data.DBUserName)
+	assert.Empty(t, data.Password)
+	assert.Equal(t, issued, data.X509)
+
+	require.NoError(t, RemoveX509Certificate(k8sClient, *user))
+	require.NoError(t, RemoveX509Certificate(k8sClient, *user))
+	assert.ErrorContains(t, ReadCredentials(k8sClient, *user, &data), "hasn't been issued yet")
+}
+
+func TestEnsureX509(t *testing.T) {
+	k8sClient := targetsClient()
+	leaf, key, ca := issueCertificate(t, time.Now(), time.Now().Add(time.Hour))
+	cert, err := ParseX509Certificate(append(append(append([]byte{}, leaf...), ca...), key...))
+	require.NoError(t, err)
+
+	data := ConnectionData{
+		DBUserName:   "user1",
+		ConnURL:      "mongodb://host1:27017,host2:27017/?ssl=true",
+		SrvConnURL:   "mongodb+srv://cluster.mongodb.net",
+		AuthDatabase: "$external",
+		X509:         cert,
+	}
+	name, err := Ensure(k8sClient, "ns", "p1", "603e7bf38a94956835659ae5", "c1", data)
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(context.Background(), kube.ObjectKey("ns", name), secret))
+	assert.Equal(t, "mongodb://host1:27017,host2:27017/?authMechanism=MONGODB-X509&authSource=%24external&ssl=true", string(secret.Data[standardKey]))
+	assert.Equal(t, "mongodb+srv://cluster.mongodb.net?authMechanism=MONGODB-X509&authSource=%24external", string(secret.Data[standardKeySrv]))
+	assert.Equal(t, leaf, secret.Data[corev1.TLSCertKey])
+	assert.Equal(t, key, secret.Data[corev1.TLSPrivateKeyKey])
+	assert.Equal(t, ca, secret.Data[caKey])
+	assert.Equal(t, "user1", string(secret.Data[userNameKey]))
+	assert.NotContains(t, secret.Data, passwordKey)
+}
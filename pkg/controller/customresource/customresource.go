@@ -24,11 +24,15 @@ const (
 	ReconciliationPolicyAnnotation = "mongodb.com/atlas-reconciliation-policy"
 	ResourceVersion                = "app.kubernetes.io/version"
 	ResourceVersionOverride        = "mongodb.com/atlas-resource-version-policy"
+	DriftPolicyAnnotation          = "mongodb.com/atlas-drift-policy"
 
 	ResourcePolicyKeep       = "keep"
 	ResourcePolicyDelete     = "delete"
 	ReconciliationPolicySkip = "skip"
+	ReconciliationPolicyPlan = "plan"
 	ResourceVersionAllow     = "allow"
+	DriftPolicyRevert        = "revert"
+	DriftPolicyReport        = "report"
 )
 
 // PrepareResource queries the Custom Resource 'request.NamespacedName' and populates the 'resource' pointer.
@@ -39,6 +43,9 @@ func PrepareResource(client client.Client, request reconcile.Request, resource m
 			// Request object not found, could have been deleted after reconcile request.
 			// Return and don't requeue
 			log.Debugf("Object %s doesn't exist, was it deleted after reconcile request?", request.NamespacedName)
+			resource.SetNamespace(request.Namespace)
+			resource.SetName(request.Name)
+			statushandler.Forget(resource)
 			return workflow.TerminateSilently().WithoutRetry()
 		}
 		// Error reading the object - requeue the request. Note, that we don't intend to update resource status
@@ -78,9 +85,16 @@ func ValidateResourceVersion(ctx *workflow.Context, resource mdbv1.AtlasCustomRe
 // Internally this will also update the 'observedGeneration' field that notify clients that the resource is being worked on
 func MarkReconciliationStarted(client client.Client, resource mdbv1.AtlasCustomResource, log *zap.SugaredLogger) *workflow.Context {
 	updatedConditions := status.EnsureConditionExists(status.FalseCondition(status.ReadyType), resource.GetStatus().GetConditions())
+	if !ReconciliationShouldBePlanned(resource) {
+		updatedConditions = status.RemoveConditionIfExists(status.ReconciliationPlannedType, updatedConditions)
+	}
 
 	ctx := workflow.NewContext(log, updatedConditions)
-	statushandler.Update(ctx, client, nil, resource)
+	// the retries start over once the spec changes
+	if resource.GetStatus().GetObservedGeneration() == resource.GetGeneration() {
+		ctx.SetLastRetry(resource.GetStatus().GetRetry())
+	}
+	statushandler.UpdateIntermediate(ctx, client, nil, resource)
 
 	return ctx
 }
@@ -136,6 +150,24 @@ func ReconciliationShouldBeSkipped(resource mdbv1.AtlasCustomResource) bool {
 	return false
 }
 
+// ReconciliationShouldBePlanned returns 'true' if the changes to Atlas should only be computed and reported
+// for this resource, without being applied.
+func ReconciliationShouldBePlanned(resource mdbv1.AtlasCustomResource) bool {
+	if v, ok := resource.GetAnnotations()[ReconciliationPolicyAnnotation]; ok {
+		return v == ReconciliationPolicyPlan
+	}
+	return false
+}
+
+// DriftShouldBeReverted returns 'true' if the changes done in Atlas outside the operator should be overridden with
+// the spec of this resource. Otherwise, the drift is only reported.
+func DriftShouldBeReverted(resource mdbv1.AtlasCustomResource) bool {
+	if v, ok := resource.GetAnnotations()[DriftPolicyAnnotation]; ok {
+		return v != DriftPolicyReport
+	}
+	return true
+}
+
 // SetAnnotation sets an annotation in resource while respecting the rest of annotations.
 func SetAnnotation(resource mdbv1.AtlasCustomResource, key, value string) {
 	annot := resource.GetAnnotations()
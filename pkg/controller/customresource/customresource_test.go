@@ -81,6 +81,38 @@ func TestReconciliationShouldBeSkipped(t *testing.T) {
 	})
 }
 
+func TestReconciliationShouldBePlanned(t *testing.T) {
+	newResourceTypes := func() []v1.AtlasCustomResource {
+		return []v1.AtlasCustomResource{
+			&v1.AtlasDeployment{},
+			&v1.AtlasDatabaseUser{},
+			&v1.AtlasProject{},
+			&v1.AtlasDataFederation{},
+		}
+	}
+
+	t.Run("Empty annotations", func(t *testing.T) {
+		for _, resourceType := range newResourceTypes() {
+			assert.False(t, ReconciliationShouldBePlanned(resourceType))
+		}
+	})
+
+	t.Run("Annotation present, reconciliation should be skipped", func(t *testing.T) {
+		for _, resourceType := range newResourceTypes() {
+			resourceType.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicySkip})
+			assert.False(t, ReconciliationShouldBePlanned(resourceType))
+		}
+	})
+
+	t.Run("Annotation present, reconciliation should be planned", func(t *testing.T) {
+		for _, resourceType := range newResourceTypes() {
+			resourceType.SetAnnotations(map[string]string{ReconciliationPolicyAnnotation: ReconciliationPolicyPlan})
+			assert.True(t, ReconciliationShouldBePlanned(resourceType))
+			assert.False(t, ReconciliationShouldBeSkipped(resourceType))
+		}
+	})
+}
+
 func TestResourceVersionIsValid(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,87 @@
+package customresource
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// ReadLastAppliedSpec fills 'spec' with the last configuration the operator applied to Atlas for the resource.
+// It returns 'false' if the operator hasn't applied any configuration yet.
+func ReadLastAppliedSpec(resource mdbv1.AtlasCustomResource, spec interface{}) (bool, error) {
+	lastApplied, ok := resource.GetAnnotations()[AnnotationLastAppliedConfiguration]
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal([]byte(lastApplied), spec); err != nil {
+		return false, fmt.Errorf("failed to read the last applied configuration: %w", err)
+	}
+
+	return true, nil
+}
+
+// DriftedFields returns the sorted paths of the fields having different values in 'lastApplied' and 'current'.
+func DriftedFields(lastApplied, current interface{}, opts ...cmp.Option) []string {
+	reporter := &fieldsReporter{fields: map[string]struct{}{}}
+	cmp.Equal(lastApplied, current, append(opts, cmp.Reporter(reporter))...)
+
+	fields := make([]string, 0, len(reporter.fields))
+	for field := range reporter.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// fieldsReporter collects the paths of the values reported as different by cmp
+type fieldsReporter struct {
+	path   cmp.Path
+	fields map[string]struct{}
+}
+
+func (r *fieldsReporter) PushStep(ps cmp.PathStep) {
+	r.path = append(r.path, ps)
+}
+
+func (r *fieldsReporter) Report(rs cmp.Result) {
+	if rs.Equal() {
+		return
+	}
+
+	var b strings.Builder
+	for _, step := range r.path {
+		switch s := step.(type) {
+		case cmp.StructField:
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(s.Name())
+		case cmp.SliceIndex:
+			// the index is missing on one side when an element was added or removed
+			ix, iy := s.SplitKeys()
+			if ix < 0 {
+				ix = iy
+			}
+			b.WriteString(fmt.Sprintf("[%d]", ix))
+		case cmp.MapIndex:
+			b.WriteString(fmt.Sprintf("[%v]", s.Key()))
+		}
+	}
+
+	field := b.String()
+	if field == "" {
+		field = "."
+	}
+	r.fields[field] = struct{}{}
+}
+
+func (r *fieldsReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
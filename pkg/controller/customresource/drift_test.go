@@ -0,0 +1,100 @@
+package customresource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+func TestReadLastAppliedSpec(t *testing.T) {
+	t.Run("No configuration applied yet", func(t *testing.T) {
+		spec := v1.AtlasDatabaseUserSpec{}
+		ok, err := ReadLastAppliedSpec(&v1.AtlasDatabaseUser{}, &spec)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Configuration applied", func(t *testing.T) {
+		user := &v1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationLastAppliedConfiguration: `{"username":"user","databaseName":"admin"}`},
+			},
+		}
+		spec := v1.AtlasDatabaseUserSpec{}
+		ok, err := ReadLastAppliedSpec(user, &spec)
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "user", spec.Username)
+		assert.Equal(t, "admin", spec.DatabaseName)
+	})
+
+	t.Run("Invalid configuration", func(t *testing.T) {
+		user := &v1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationLastAppliedConfiguration: `{`},
+			},
+		}
+		spec := v1.AtlasDatabaseUserSpec{}
+		_, err := ReadLastAppliedSpec(user, &spec)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDriftedFields(t *testing.T) {
+	type item struct {
+		Name  string
+		Value int
+	}
+	type spec struct {
+		Name   string
+		Items  []item
+		Labels map[string]string
+	}
+
+	t.Run("No drift", func(t *testing.T) {
+		s := spec{Name: "a", Items: []item{{Name: "b", Value: 1}}}
+
+		assert.Empty(t, DriftedFields(s, s))
+	})
+
+	t.Run("Changed fields are listed", func(t *testing.T) {
+		lastApplied := spec{Name: "a", Items: []item{{Name: "b", Value: 1}}, Labels: map[string]string{"k": "v"}}
+		current := spec{Name: "c", Items: []item{{Name: "b", Value: 2}, {Name: "d"}}, Labels: map[string]string{"k": "w"}}
+
+		assert.Equal(
+			t,
+			[]string{"Items[0].Value", "Items[1]", "Labels[k]", "Name"},
+			DriftedFields(lastApplied, current),
+		)
+	})
+
+	t.Run("Options are applied", func(t *testing.T) {
+		assert.Empty(t, DriftedFields(spec{Items: []item{}}, spec{}, cmpopts.EquateEmpty()))
+	})
+}
+
+func TestDriftShouldBeReverted(t *testing.T) {
+	t.Run("Empty annotations", func(t *testing.T) {
+		assert.True(t, DriftShouldBeReverted(&v1.AtlasDeployment{}))
+	})
+
+	t.Run("Revert policy", func(t *testing.T) {
+		deployment := &v1.AtlasDeployment{}
+		deployment.SetAnnotations(map[string]string{DriftPolicyAnnotation: DriftPolicyRevert})
+		assert.True(t, DriftShouldBeReverted(deployment))
+	})
+
+	t.Run("Report policy", func(t *testing.T) {
+		deployment := &v1.AtlasDeployment{}
+		deployment.SetAnnotations(map[string]string{DriftPolicyAnnotation: DriftPolicyReport})
+		assert.False(t, DriftShouldBeReverted(deployment))
+	})
+}
@@ -6,28 +6,53 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
 )
 
 // Update performs the update (in the form of patch) for the Atlas Custom Resource status.
-// It should be a common method for all the controllers
+// It should be a common method for all the controllers. It's the final status update of a reconciliation, so the
+// status conditions are exposed as metrics as well.
 func Update(ctx *workflow.Context, kubeClient client.Client, eventRecorder record.EventRecorder, resource mdbv1.AtlasCustomResource) {
+	if update(ctx, kubeClient, eventRecorder, resource) {
+		conditions.record(resource, ctx.Conditions())
+	}
+}
+
+// UpdateIntermediate performs the update of the Atlas Custom Resource status while the reconciliation is still in
+// progress. Unlike Update, it doesn't expose the status conditions as metrics.
+func UpdateIntermediate(ctx *workflow.Context, kubeClient client.Client, eventRecorder record.EventRecorder, resource mdbv1.AtlasCustomResource) {
+	update(ctx, kubeClient, eventRecorder, resource)
+}
+
+// Forget removes the Atlas Custom Resource from the status condition metrics once it doesn't exist anymore
+func Forget(resource mdbv1.AtlasCustomResource) {
+	conditions.forget(resource)
+}
+
+// update patches the status and returns false if the resource doesn't exist anymore
+func update(ctx *workflow.Context, kubeClient client.Client, eventRecorder record.EventRecorder, resource mdbv1.AtlasCustomResource) bool {
 	if ctx.LastCondition() != nil {
 		logEvent(ctx, eventRecorder, resource)
 	}
 
 	resource.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+	if retryWriter, ok := resource.(status.RetryWriter); ok {
+		retryWriter.SetRetry(ctx.Retry())
+	}
 
 	if err := patchUpdateStatus(kubeClient, resource); err != nil {
 		if apiErrors.IsNotFound(err) {
+			conditions.forget(resource)
 			ctx.Log.Infof("The resource %s no longer exists, not updating the status", kube.ObjectKey(resource.GetNamespace(), resource.GetName()))
-			return
+			return false
 		}
 		// Implementation logic: we deliberately don't return the 'error' to avoid cumbersome handling logic as the
 		// failed update of the status is not something that should block reconciliation
 		ctx.Log.Errorf("Failed to update status: %s", err)
 	}
+	return true
 }
 
 // logEvent logs the last condition to the output and also creates the Event for it in Kubernetes.
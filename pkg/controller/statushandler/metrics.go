@@ -0,0 +1,108 @@
+package statushandler
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+var conditionLabels = []string{"namespace", "kind", "type", "status", "reason"}
+
+// conditions are the metrics populated from the status conditions of the Atlas Custom Resources every time their
+// status is updated
+var conditions = newConditionMetrics(
+	prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "atlas_operator",
+		Name:      "resource_conditions",
+		Help:      "Number of Atlas Custom Resources having a status condition by namespace, kind, condition type, status and reason",
+	}, conditionLabels),
+	prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "atlas_operator",
+		Name:      "reconcile_conditions_total",
+		Help:      "Total number of reconciliations which ended with a status condition by namespace, kind, condition type, status and reason",
+	}, conditionLabels),
+)
+
+func init() {
+	metrics.Registry.MustRegister(conditions.resources, conditions.reconciles)
+}
+
+type resourceKey struct {
+	kind string
+	name types.NamespacedName
+}
+
+type conditionMetrics struct {
+	resources  *prometheus.GaugeVec
+	reconciles *prometheus.CounterVec
+
+	mu sync.Mutex
+	// current keeps the labels of the conditions each resource is counted with in the 'resources' gauge
+	current map[resourceKey][]prometheus.Labels
+}
+
+func newConditionMetrics(resources *prometheus.GaugeVec, reconciles *prometheus.CounterVec) *conditionMetrics {
+	return &conditionMetrics{
+		resources:  resources,
+		reconciles: reconciles,
+		current:    map[resourceKey][]prometheus.Labels{},
+	}
+}
+
+// record counts the reconciliation outcome and moves the resource to its new conditions in the gauge
+func (m *conditionMetrics) record(resource mdbv1.AtlasCustomResource, conditions []status.Condition) {
+	key := keyOf(resource)
+	labels := make([]prometheus.Labels, 0, len(conditions))
+	for _, condition := range conditions {
+		conditionLabels := prometheus.Labels{
+			"namespace": resource.GetNamespace(),
+			"kind":      key.kind,
+			"type":      string(condition.Type),
+			"status":    string(condition.Status),
+			"reason":    condition.Reason,
+		}
+		labels = append(labels, conditionLabels)
+		m.reconciles.With(conditionLabels).Inc()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.decrement(key)
+	for _, conditionLabels := range labels {
+		m.resources.With(conditionLabels).Inc()
+	}
+	m.current[key] = labels
+}
+
+// forget removes the resource from the gauge, it must be called once the resource doesn't exist anymore
+func (m *conditionMetrics) forget(resource mdbv1.AtlasCustomResource) {
+	key := keyOf(resource)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.decrement(key)
+	delete(m.current, key)
+}
+
+func (m *conditionMetrics) decrement(key resourceKey) {
+	for _, conditionLabels := range m.current[key] {
+		m.resources.With(conditionLabels).Dec()
+	}
+}
+
+// keyOf identifies the resource by its Go type as the kind is not always populated in the objects read from the cache
+func keyOf(resource mdbv1.AtlasCustomResource) resourceKey {
+	return resourceKey{
+		kind: reflect.Indirect(reflect.ValueOf(resource)).Type().Name(),
+		name: kube.ObjectKeyFromObject(resource),
+	}
+}
@@ -0,0 +1,71 @@
+package statushandler
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/workflow"
+)
+
+func TestConditionMetrics(t *testing.T) {
+	metrics := newConditionMetrics(
+		prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "resources"}, conditionLabels),
+		prometheus.NewCounterVec(prometheus.CounterOpts{Name: "reconciles"}, conditionLabels),
+	)
+	deployment := func(name string) *mdbv1.AtlasDeployment {
+		return &mdbv1.AtlasDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"}}
+	}
+	updating := status.Condition{Type: status.DeploymentReadyType, Status: corev1.ConditionFalse, Reason: string(workflow.DeploymentUpdating)}
+	ready := status.Condition{Type: status.DeploymentReadyType, Status: corev1.ConditionTrue}
+
+	metrics.record(deployment("first"), []status.Condition{updating})
+	metrics.record(deployment("second"), []status.Condition{updating})
+	metrics.record(deployment("second"), []status.Condition{updating})
+
+	updatingValues := []string{"test-ns", "AtlasDeployment", "DeploymentReady", "False", "DeploymentUpdating"}
+	readyValues := []string{"test-ns", "AtlasDeployment", "DeploymentReady", "True", ""}
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.resources.WithLabelValues(updatingValues...)))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.reconciles.WithLabelValues(updatingValues...)))
+
+	metrics.record(deployment("first"), []status.Condition{ready})
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.resources.WithLabelValues(updatingValues...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.resources.WithLabelValues(readyValues...)))
+
+	metrics.forget(deployment("second"))
+	metrics.forget(deployment("unknown"))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.resources.WithLabelValues(updatingValues...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.resources.WithLabelValues(readyValues...)))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.reconciles.WithLabelValues(updatingValues...)))
+}
+
+func TestUpdateRecordsFinalConditions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+	deployment := &mdbv1.AtlasDeployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "update-test-ns"}}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	readyValues := []string{"update-test-ns", "AtlasDeployment", "Ready", "True", ""}
+
+	ctx := workflow.NewContext(zap.S(), []status.Condition{})
+	ctx.SetConditionTrue(status.ReadyType)
+	UpdateIntermediate(ctx, kubeClient, record.NewFakeRecorder(10), deployment)
+	assert.Equal(t, 0.0, testutil.ToFloat64(conditions.resources.WithLabelValues(readyValues...)))
+
+	Update(ctx, kubeClient, record.NewFakeRecorder(10), deployment)
+	assert.Equal(t, 1.0, testutil.ToFloat64(conditions.resources.WithLabelValues(readyValues...)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(conditions.reconciles.WithLabelValues(readyValues...)))
+
+	Forget(deployment)
+	assert.Equal(t, 0.0, testutil.ToFloat64(conditions.resources.WithLabelValues(readyValues...)))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
 )
 
 type googleServiceAccountKey struct {
@@ -87,10 +88,51 @@ func Project(project *mdbv1.AtlasProject) error {
 	return nil
 }
 
-func DatabaseUser(_ *mdbv1.AtlasDatabaseUser) error {
+func DatabaseUser(dbUser *mdbv1.AtlasDatabaseUser) error {
+	if dbUser.Spec.X509Type == connectionsecret.X509ManagedType {
+		if dbUser.Spec.DatabaseName != "$external" {
+			return errors.New("spec.databaseName must be '$external' for the MANAGED X.509 users")
+		}
+		if dbUser.Spec.PasswordSecret != nil && dbUser.Spec.PasswordSecret.Name != "" {
+			return errors.New("spec.passwordSecretRef must not be set for the MANAGED X.509 users")
+		}
+		if dbUser.Spec.GeneratePassword != nil {
+			return errors.New("spec.generatePassword must not be set for the MANAGED X.509 users")
+		}
+	}
+	if policy := dbUser.Spec.GeneratePassword; policy != nil && policy.Length != 0 && policy.Digits+policy.Symbols > policy.Length {
+		return errors.New("spec.generatePassword is invalid: the number of digits and symbols exceeds the length")
+	}
+	if dbUser.Spec.ConnectionSecretTemplate != nil {
+		if _, err := connectionsecret.ParseTemplate(dbUser.Spec.ConnectionSecretTemplate); err != nil {
+			return fmt.Errorf("spec.connectionSecretTemplate is invalid: %w", err)
+		}
+	}
 	return nil
 }
 
+func DataFederation(dataFederation *mdbv1.AtlasDataFederation) error {
+	var err error
+
+	if dataFederation.Spec.Project.Name == "" {
+		err = errors.Join(err, errors.New("spec.projectRef.name must be set"))
+	}
+
+	if dataFederation.Spec.Name == "" {
+		err = errors.Join(err, errors.New("spec.name must be set"))
+	}
+
+	if dataFederation.Spec.Storage != nil {
+		err = errors.Join(err, dataFederationStorage(dataFederation.Spec.Storage))
+	}
+
+	return err
+}
+
+// BackupSchedule validates the schedule against the deployment using it.
+// deployment may be nil when no AtlasDeployment references the schedule yet, in which case
+// the checks depending on the deployment are skipped. The replication spec IDs are known only once the
+// deployment has been reconciled, so they aren't checked before that.
 func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.AtlasDeployment) error {
 	var err error
 
@@ -99,7 +141,7 @@ func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.Atla
 	}
 
 	replicaSets := map[string]struct{}{}
-	if deployment.Status.ReplicaSets != nil {
+	if deployment != nil {
 		for _, replicaSet := range deployment.Status.ReplicaSets {
 			replicaSets[replicaSet.ID] = struct{}{}
 		}
@@ -112,11 +154,11 @@ func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.Atla
 
 		if copySetting.ReplicationSpecID == nil {
 			err = errors.Join(err, fmt.Errorf("copy setting at position %d: you must set a valid ReplicationSpecID", position))
-		} else if _, ok := replicaSets[*copySetting.ReplicationSpecID]; !ok {
+		} else if _, ok := replicaSets[*copySetting.ReplicationSpecID]; len(replicaSets) > 0 && !ok {
 			err = errors.Join(err, fmt.Errorf("copy setting at position %d: referenced ReplicationSpecID is invalid", position))
 		}
 
-		if copySetting.ShouldCopyOplogs != nil && *copySetting.ShouldCopyOplogs {
+		if deployment != nil && copySetting.ShouldCopyOplogs != nil && *copySetting.ShouldCopyOplogs {
 			if deployment.Spec.AdvancedDeploymentSpec != nil &&
 				(deployment.Spec.AdvancedDeploymentSpec.PitEnabled == nil ||
 					!*deployment.Spec.AdvancedDeploymentSpec.PitEnabled) {
@@ -297,6 +339,43 @@ func encryptionAtRest(encryption *mdbv1.EncryptionAtRest) error {
 	return nil
 }
 
+func dataFederationStorage(storage *mdbv1.Storage) error {
+	var err error
+	stores := map[string]struct{}{}
+
+	for _, store := range storage.Stores {
+		if store.Name == "" {
+			err = errors.Join(err, errors.New("storage store name must be set"))
+			continue
+		}
+
+		if _, ok := stores[store.Name]; ok {
+			err = errors.Join(err, fmt.Errorf("the store \"%s\" is duplicate. store name must be unique", store.Name))
+		}
+
+		stores[store.Name] = struct{}{}
+	}
+
+	databases := map[string]struct{}{}
+	for _, database := range storage.Databases {
+		if _, ok := databases[database.Name]; ok {
+			err = errors.Join(err, fmt.Errorf("the database \"%s\" is duplicate. database name must be unique", database.Name))
+		}
+
+		databases[database.Name] = struct{}{}
+
+		for _, collection := range database.Collections {
+			for _, dataSource := range collection.DataSources {
+				if _, ok := stores[dataSource.StoreName]; !ok {
+					err = errors.Join(err, fmt.Errorf("data source of collection \"%s.%s\" references unknown store \"%s\"", database.Name, collection.Name, dataSource.StoreName))
+				}
+			}
+		}
+	}
+
+	return err
+}
+
 func unfilter(key string) string {
 	return strings.ReplaceAll(key, "\\\\n", "\\n")
 }
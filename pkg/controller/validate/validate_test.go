@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
@@ -322,6 +323,41 @@ func TestBackupScheduleValidation(t *testing.T) {
 		assert.Error(t, BackupSchedule(bSchedule, deployment))
 	})
 
+	t.Run("deployment dependent checks are skipped without a deployment", func(t *testing.T) {
+		bSchedule := &mdbv1.AtlasBackupSchedule{
+			Spec: mdbv1.AtlasBackupScheduleSpec{
+				CopySettings: []mdbv1.CopySetting{
+					{
+						RegionName:        toptr.MakePtr("US_EAST_1"),
+						ReplicationSpecID: toptr.MakePtr("123456"),
+						ShouldCopyOplogs:  toptr.MakePtr(true),
+					},
+				},
+			},
+		}
+		assert.NoError(t, BackupSchedule(bSchedule, nil))
+	})
+
+	t.Run("replication spec IDs are not checked before the deployment is reconciled", func(t *testing.T) {
+		bSchedule := &mdbv1.AtlasBackupSchedule{
+			Spec: mdbv1.AtlasBackupScheduleSpec{
+				CopySettings: []mdbv1.CopySetting{
+					{
+						RegionName:        toptr.MakePtr("US_EAST_1"),
+						ReplicationSpecID: toptr.MakePtr("123456"),
+					},
+				},
+			},
+		}
+		deployment := &mdbv1.AtlasDeployment{
+			Status: status.AtlasDeploymentStatus{},
+		}
+		assert.NoError(t, BackupSchedule(bSchedule, deployment))
+
+		deployment.Status.ReplicaSets = []status.ReplicaSet{{ID: "other"}}
+		assert.ErrorContains(t, BackupSchedule(bSchedule, deployment), "referenced ReplicationSpecID is invalid")
+	})
+
 	t.Run("copy settings on advanced deployment", func(t *testing.T) {
 		t.Run("copy settings is valid", func(t *testing.T) {
 			bSchedule := &mdbv1.AtlasBackupSchedule{
@@ -485,6 +521,90 @@ func withProperUrls(properties string) string {
 	return fmt.Sprintf(`{%s, %s}`, urls, properties)
 }
 
+func TestDatabaseUserValidation(t *testing.T) {
+	t.Run("valid connection secret template", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			ConnectionSecretTemplate: &mdbv1.ConnectionSecretTemplate{Data: map[string]string{"MONGODB_URI": "{{.ConnectionStringStandardSrv}}"}},
+		}}
+		assert.NoError(t, DatabaseUser(dbUser))
+	})
+
+	t.Run("invalid connection secret template", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			ConnectionSecretTemplate: &mdbv1.ConnectionSecretTemplate{Data: map[string]string{"MONGODB_URI": "{{.ConnectionStringStandardSrv"}},
+		}}
+		assert.ErrorContains(t, DatabaseUser(dbUser), "spec.connectionSecretTemplate is invalid")
+	})
+
+	t.Run("valid MANAGED X.509 user", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{X509Type: "MANAGED", DatabaseName: "$external"}}
+		assert.NoError(t, DatabaseUser(dbUser))
+	})
+
+	t.Run("MANAGED X.509 user with a database other than $external", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{X509Type: "MANAGED", DatabaseName: "admin"}}
+		assert.ErrorContains(t, DatabaseUser(dbUser), "spec.databaseName must be '$external'")
+	})
+
+	t.Run("MANAGED X.509 user with a password", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			X509Type:       "MANAGED",
+			DatabaseName:   "$external",
+			PasswordSecret: &common.ResourceRef{Name: "password"},
+		}}
+		assert.ErrorContains(t, DatabaseUser(dbUser), "spec.passwordSecretRef must not be set")
+	})
+
+	t.Run("generated password with too many digits and symbols", func(t *testing.T) {
+		dbUser := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			GeneratePassword: &mdbv1.PasswordPolicy{Length: 10, Digits: 6, Symbols: 6},
+		}}
+		assert.ErrorContains(t, DatabaseUser(dbUser), "spec.generatePassword is invalid")
+	})
+}
+
+func TestDataFederationValidation(t *testing.T) {
+	t.Run("valid data federation", func(t *testing.T) {
+		dataFederation := mdbv1.NewDataFederationInstance("my-project", "my-df", "default")
+		dataFederation.Spec.Storage = &mdbv1.Storage{
+			Databases: []mdbv1.Database{
+				{
+					Name: "db",
+					Collections: []mdbv1.Collection{
+						{Name: "col", DataSources: []mdbv1.DataSource{{StoreName: "store"}}},
+					},
+				},
+			},
+			Stores: []mdbv1.Store{{Name: "store", Provider: "s3"}},
+		}
+		assert.NoError(t, DataFederation(dataFederation))
+	})
+
+	t.Run("missing name and project", func(t *testing.T) {
+		err := DataFederation(&mdbv1.AtlasDataFederation{})
+		assert.ErrorContains(t, err, "spec.projectRef.name must be set")
+		assert.ErrorContains(t, err, "spec.name must be set")
+	})
+
+	t.Run("duplicate stores and unknown store reference", func(t *testing.T) {
+		dataFederation := mdbv1.NewDataFederationInstance("my-project", "my-df", "default")
+		dataFederation.Spec.Storage = &mdbv1.Storage{
+			Databases: []mdbv1.Database{
+				{
+					Name: "db",
+					Collections: []mdbv1.Collection{
+						{Name: "col", DataSources: []mdbv1.DataSource{{StoreName: "other"}}},
+					},
+				},
+			},
+			Stores: []mdbv1.Store{{Name: "store"}, {Name: "store"}},
+		}
+		err := DataFederation(dataFederation)
+		assert.ErrorContains(t, err, "the store \"store\" is duplicate")
+		assert.ErrorContains(t, err, "references unknown store \"other\"")
+	})
+}
+
 func TestEncryptionAtRestValidation(t *testing.T) {
 	t.Run("google service account key validation succeeds if no encryption at rest is used", func(t *testing.T) {
 		assert.NoError(t, encryptionAtRest(&mdbv1.EncryptionAtRest{}))
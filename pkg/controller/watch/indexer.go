@@ -0,0 +1,205 @@
+package watch
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
+)
+
+// The field indexes of the resources referencing other Kubernetes objects. The value indexed is the 'namespace/name'
+// of the referenced object.
+const (
+	// ProjectSecretsIndex indexes the AtlasProjects by the Secrets they reference
+	ProjectSecretsIndex = "atlasproject.spec.secretRefs"
+	// ProjectDefaultCredentialsIndex indexes the AtlasProjects by their namespace, the projects inherit the credentials
+	// missing in their connection Secret from the default credentials of the namespace
+	ProjectDefaultCredentialsIndex = "atlasproject.spec.connectionSecretRef.default"
+	// ProjectTeamsIndex indexes the AtlasProjects by the AtlasTeams they reference
+	ProjectTeamsIndex = "atlasproject.spec.teams.teamRef"
+	// DatabaseUserSecretsIndex indexes the AtlasDatabaseUsers by their password Secret and the Secret keeping the X.509
+	// certificate issued by Atlas
+	DatabaseUserSecretsIndex = "atlasdatabaseuser.spec.passwordSecretRef"
+	// DeploymentBackupScheduleIndex indexes the AtlasDeployments by their AtlasBackupSchedule
+	DeploymentBackupScheduleIndex = "atlasdeployment.spec.backupRef"
+	// BackupSchedulePolicyIndex indexes the AtlasBackupSchedules by their AtlasBackupPolicy
+	BackupSchedulePolicyIndex = "atlasbackupschedule.spec.policy"
+)
+
+// ProjectSecretRefs returns the Secrets referenced by the AtlasProject: the connection Secret, the X.509 certificate,
+// the encryption at rest, integrations and alert notifications credentials.
+// Note, that the global connection secret isn't indexed - there is no point in reconciling all the projects once it's changed
+func ProjectSecretRefs(obj client.Object) []string {
+	project, ok := obj.(*mdbv1.AtlasProject)
+	if !ok {
+		return nil
+	}
+
+	refs := newRefSet(project.Namespace)
+	if project.Spec.ConnectionSecret != nil {
+		refs.add(*project.Spec.ConnectionSecret)
+	}
+	if project.Spec.X509CertRef != nil {
+		refs.add(*project.Spec.X509CertRef)
+	}
+	if encryption := project.Spec.EncryptionAtRest; encryption != nil {
+		refs.add(encryption.AwsKms.SecretRef, encryption.GoogleCloudKms.SecretRef, encryption.AzureKeyVault.SecretRef)
+	}
+	for _, integration := range project.Spec.Integrations {
+		refs.add(
+			integration.LicenseKeyRef,
+			integration.WriteTokenRef,
+			integration.ReadTokenRef,
+			integration.APIKeyRef,
+			integration.ServiceKeyRef,
+			integration.APITokenRef,
+			integration.RoutingKeyRef,
+			integration.SecretRef,
+			integration.PasswordRef,
+		)
+	}
+	for _, alertConfig := range project.Spec.AlertConfigurations {
+		for _, notification := range alertConfig.Notifications {
+			refs.add(
+				notification.APITokenRef,
+				notification.DatadogAPIKeyRef,
+				notification.FlowdockAPITokenRef,
+				notification.OpsGenieAPIKeyRef,
+				notification.ServiceKeyRef,
+				notification.VictorOpsSecretRef,
+			)
+		}
+	}
+	return refs.keys
+}
+
+// ProjectDefaultCredentialsRefs returns the namespace of the AtlasProject whose default credentials it may use
+func ProjectDefaultCredentialsRefs(obj client.Object) []string {
+	project, ok := obj.(*mdbv1.AtlasProject)
+	if !ok {
+		return nil
+	}
+	return []string{project.Namespace}
+}
+
+// ProjectTeamRefs returns the AtlasTeams assigned to the AtlasProject
+func ProjectTeamRefs(obj client.Object) []string {
+	project, ok := obj.(*mdbv1.AtlasProject)
+	if !ok {
+		return nil
+	}
+
+	refs := newRefSet(project.Namespace)
+	for _, team := range project.Spec.Teams {
+		refs.add(team.TeamRef)
+	}
+	return refs.keys
+}
+
+// DatabaseUserSecretRefs returns the password Secret and the X.509 certificate Secret of the AtlasDatabaseUser
+func DatabaseUserSecretRefs(obj client.Object) []string {
+	user, ok := obj.(*mdbv1.AtlasDatabaseUser)
+	if !ok {
+		return nil
+	}
+	refs := newRefSet(user.Namespace)
+	if key := user.PasswordSecretObjectKey(); key != nil {
+		refs.add(common.ResourceRefNamespaced{Name: key.Name})
+	}
+	// The certificate issued by Atlas is reissued once its Secret is removed or broken
+	if user.Spec.X509Type == connectionsecret.X509ManagedType {
+		refs.add(common.ResourceRefNamespaced{Name: connectionsecret.X509SecretName(*user)})
+	}
+	return refs.keys
+}
+
+// DeploymentBackupScheduleRefs returns the AtlasBackupSchedule of the AtlasDeployment
+func DeploymentBackupScheduleRefs(obj client.Object) []string {
+	deployment, ok := obj.(*mdbv1.AtlasDeployment)
+	if !ok {
+		return nil
+	}
+
+	refs := newRefSet(deployment.Namespace)
+	refs.add(deployment.Spec.BackupScheduleRef)
+	return refs.keys
+}
+
+// BackupSchedulePolicyRefs returns the AtlasBackupPolicy of the AtlasBackupSchedule
+func BackupSchedulePolicyRefs(obj client.Object) []string {
+	schedule, ok := obj.(*mdbv1.AtlasBackupSchedule)
+	if !ok {
+		return nil
+	}
+
+	refs := newRefSet(schedule.Namespace)
+	refs.add(schedule.Spec.PolicyRef)
+	return refs.keys
+}
+
+// DependantsFunc returns the keys of the resources depending on the watched object
+type DependantsFunc func(ctx context.Context, watched client.ObjectKey) ([]client.ObjectKey, error)
+
+// IndexedDependants returns the DependantsFunc looking up the resources of the list type which reference the watched
+// object in the index. The lookup is served by the cache of the manager, so it's safe to be called concurrently and
+// doesn't depend on the resources being reconciled before.
+func IndexedDependants(reader client.Reader, list client.ObjectList, index string) DependantsFunc {
+	return indexedDependants(reader, list, index, client.ObjectKey.String)
+}
+
+// NamespaceIndexedDependants returns the DependantsFunc looking up the resources of the list type indexed by the
+// namespace of the watched object
+func NamespaceIndexedDependants(reader client.Reader, list client.ObjectList, index string) DependantsFunc {
+	return indexedDependants(reader, list, index, func(watched client.ObjectKey) string {
+		return watched.Namespace
+	})
+}
+
+func indexedDependants(reader client.Reader, list client.ObjectList, index string, value func(client.ObjectKey) string) DependantsFunc {
+	return func(ctx context.Context, watched client.ObjectKey) ([]client.ObjectKey, error) {
+		dependants := list.DeepCopyObject().(client.ObjectList)
+		if err := reader.List(ctx, dependants, client.MatchingFields{index: value(watched)}); err != nil {
+			return nil, err
+		}
+
+		var keys []client.ObjectKey
+		err := meta.EachListItem(dependants, func(item runtime.Object) error {
+			obj, ok := item.(client.Object)
+			if ok {
+				keys = append(keys, client.ObjectKeyFromObject(obj))
+			}
+			return nil
+		})
+		return keys, err
+	}
+}
+
+// refSet collects the unique 'namespace/name' keys of the references, the references without the namespace belong to
+// the namespace of the parent resource
+type refSet struct {
+	parentNamespace string
+	keys            []string
+	seen            map[string]bool
+}
+
+func newRefSet(parentNamespace string) *refSet {
+	return &refSet{parentNamespace: parentNamespace, seen: map[string]bool{}}
+}
+
+func (s *refSet) add(refs ...common.ResourceRefNamespaced) {
+	for i := range refs {
+		if refs[i].Name == "" {
+			continue
+		}
+		key := refs[i].GetObject(s.parentNamespace).String()
+		if !s.seen[key] {
+			s.seen[key] = true
+			s.keys = append(s.keys, key)
+		}
+	}
+}
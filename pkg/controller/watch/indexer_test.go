@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+func TestProjectSecretRefs(t *testing.T) {
+	atlasProject := mdbv1.DefaultProject("ns", "connection")
+	atlasProject.Spec.Integrations = []project.Integration{
+		{APIKeyRef: common.ResourceRefNamespaced{Name: "datadog", Namespace: "other-ns"}},
+		{PasswordRef: common.ResourceRefNamespaced{Name: "connection"}},
+	}
+	atlasProject.Spec.AlertConfigurations = []mdbv1.AlertConfiguration{
+		{Notifications: []mdbv1.Notification{{ServiceKeyRef: common.ResourceRefNamespaced{Name: "pager-duty"}}}},
+	}
+
+	assert.Equal(t, []string{"ns/connection", "other-ns/datadog", "ns/pager-duty"}, ProjectSecretRefs(atlasProject))
+	assert.Empty(t, ProjectSecretRefs(&mdbv1.AtlasDeployment{}))
+}
+
+func TestDatabaseUserSecretRefs(t *testing.T) {
+	user := mdbv1.DefaultDBUser("ns", "theuser", "project").WithPasswordSecret("user-password")
+	assert.Equal(t, []string{"ns/user-password"}, DatabaseUserSecretRefs(user))
+
+	user.Spec.PasswordSecret = nil
+	user.Spec.X509Type = "MANAGED"
+	assert.Equal(t, []string{"ns/theuser-x509"}, DatabaseUserSecretRefs(user))
+	assert.Empty(t, DatabaseUserSecretRefs(&mdbv1.AtlasDeployment{}))
+}
+
+func TestIndexedDependants(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	newProject := func(name string, teams ...string) *mdbv1.AtlasProject {
+		p := &mdbv1.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+		for _, team := range teams {
+			p.Spec.Teams = append(p.Spec.Teams, mdbv1.Team{TeamRef: common.ResourceRefNamespaced{Name: team}})
+		}
+		return p
+	}
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newProject("first", "devs"), newProject("second", "devs", "admins"), newProject("third")).
+		WithIndex(&mdbv1.AtlasProject{}, ProjectTeamsIndex, ProjectTeamRefs).
+		Build()
+	dependants := IndexedDependants(kubeClient, &mdbv1.AtlasProjectList{}, ProjectTeamsIndex)
+
+	projects, err := dependants(context.Background(), kube.ObjectKey("ns", "devs"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []client.ObjectKey{kube.ObjectKey("ns", "first"), kube.ObjectKey("ns", "second")}, projects)
+
+	projects, err = dependants(context.Background(), kube.ObjectKey("ns", "admins"))
+	require.NoError(t, err)
+	assert.Equal(t, []client.ObjectKey{kube.ObjectKey("ns", "second")}, projects)
+
+	projects, err = dependants(context.Background(), kube.ObjectKey("other-ns", "devs"))
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+}
+
+func TestNamespaceIndexedDependants(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mdbv1.DefaultProject("ns", "connection"), mdbv1.DefaultProject("other-ns", "").WithName("default")).
+		WithIndex(&mdbv1.AtlasProject{}, ProjectDefaultCredentialsIndex, ProjectDefaultCredentialsRefs).
+		Build()
+	dependants := NamespaceIndexedDependants(kubeClient, &mdbv1.AtlasProjectList{}, ProjectDefaultCredentialsIndex)
+
+	projects, err := dependants(context.Background(), kube.ObjectKey("ns", "default-credentials"))
+	require.NoError(t, err)
+	assert.Equal(t, []client.ObjectKey{kube.ObjectKey("ns", "test-project")}, projects)
+
+	projects, err = dependants(context.Background(), kube.ObjectKey("other-ns", "default-credentials"))
+	require.NoError(t, err)
+	assert.Equal(t, []client.ObjectKey{kube.ObjectKey("other-ns", "default")}, projects)
+}
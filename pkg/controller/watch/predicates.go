@@ -49,3 +49,25 @@ func SelectNamespacesPredicate(namespaceMap map[string]bool) predicate.Funcs {
 		return false
 	})
 }
+
+// HasLabelPredicate returns a predicate selecting the objects with the label. The update is selected if either the old
+// or the new object has the label, so adding and removing the label are handled as well.
+func HasLabelPredicate(key, value string) predicate.Funcs {
+	hasLabel := func(object client.Object) bool {
+		return object.GetLabels()[key] == value
+	}
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return hasLabel(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasLabel(e.ObjectOld) || hasLabel(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return hasLabel(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return hasLabel(e.Object)
+		},
+	}
+}
@@ -1,61 +1,51 @@
 package watch
 
 import (
-	"fmt"
+	"context"
 	"reflect"
 
-	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
-
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-)
-
-// WatchedObject is the  object watched by controller. Includes its type and namespace+name
-type WatchedObject struct {
-	ResourceKind string
-	Resource     types.NamespacedName
-}
 
-func (w WatchedObject) String() string {
-	return fmt.Sprintf("%s (%s)", w.Resource, w.ResourceKind)
-}
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
 
-// ResourcesHandler is a special implementation of 'handler.EventHandler' that checks if the event for
-// WatchedObject must trigger reconciliation for any Operator managed Resource (AtlasProject, AtlasDeployment etc). This is
-// done via consulting the 'TrackedResources' map. The map is stored in relevant Reconciler which ensures it's up-to-date
-// on each reconciliation
+// ResourcesHandler is a special implementation of 'handler.EventHandler' that checks if the event for the watched
+// object must trigger reconciliation for any Operator managed Resource (AtlasProject, AtlasDeployment etc). The
+// dependant resources are looked up in the field indexes of the manager cache, so the handler doesn't keep any state
+// and is safe to be used by the controllers running concurrent reconciliations
 type ResourcesHandler struct {
-	ResourceKind     string
-	TrackedResources map[WatchedObject]map[client.ObjectKey]bool
+	ResourceKind string
+	Dependants   DependantsFunc
 }
 
-// NewSecretHandler TODO Igor: refactor this to create generic constructor
-func NewSecretHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
-	return &ResourcesHandler{ResourceKind: "Secret", TrackedResources: tracked}
+func NewSecretHandler(dependants DependantsFunc) *ResourcesHandler {
+	return &ResourcesHandler{ResourceKind: "Secret", Dependants: dependants}
 }
 
-func NewBackupScheduleHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
-	return &ResourcesHandler{ResourceKind: "AtlasBackupSchedule", TrackedResources: tracked}
+func NewBackupScheduleHandler(dependants DependantsFunc) *ResourcesHandler {
+	return &ResourcesHandler{ResourceKind: "AtlasBackupSchedule", Dependants: dependants}
 }
 
-func NewBackupPolicyHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
-	return &ResourcesHandler{ResourceKind: "AtlasBackupPolicy", TrackedResources: tracked}
+func NewBackupPolicyHandler(dependants DependantsFunc) *ResourcesHandler {
+	return &ResourcesHandler{ResourceKind: "AtlasBackupPolicy", Dependants: dependants}
 }
 
-func NewAtlasTeamHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
-	return &ResourcesHandler{ResourceKind: "AtlasTeam", TrackedResources: tracked}
+func NewAtlasTeamHandler(dependants DependantsFunc) *ResourcesHandler {
+	return &ResourcesHandler{ResourceKind: "AtlasTeam", Dependants: dependants}
 }
 
 // Create handles the Create event for the resource.
 // Note that we implement Create in addition to Update to be able to handle cases when config map or secret is deleted
-// and then created again.
+// and then created again. This also covers the objects listed once the operator starts, so the changes done while
+// it wasn't running are not missed.
 func (c *ResourcesHandler) Create(e event.CreateEvent, q workqueue.RateLimitingInterface) {
-	c.doHandle(e.Object.GetNamespace(), e.Object.GetName(), e.Object.GetObjectKind().GroupVersionKind().Kind, q)
+	c.doHandle(kube.ObjectKeyFromObject(e.Object), q)
 }
 
 func (c *ResourcesHandler) Update(e event.UpdateEvent, q workqueue.RateLimitingInterface) {
@@ -63,9 +53,7 @@ func (c *ResourcesHandler) Update(e event.UpdateEvent, q workqueue.RateLimitingI
 		zap.S().Debugf("resource watcher: skipping update for resource %v", e.ObjectNew.GetName())
 		return
 	}
-	// For some reasons e.ObjectOld.GetObjectKind().GroupVersionKind().Kind is empty... that's why we have to keep the
-	// resourceKind as a field in the handler...
-	c.doHandle(e.ObjectOld.GetNamespace(), e.ObjectNew.GetName(), c.ResourceKind, q)
+	c.doHandle(kube.ObjectKeyFromObject(e.ObjectNew), q)
 }
 
 // shouldHandleUpdate return true if the update event must be handled. This should happen only if the real data has
@@ -75,26 +63,33 @@ func shouldHandleUpdate(e event.UpdateEvent) bool {
 	case *corev1.ConfigMap:
 		return !reflect.DeepEqual(v.Data, e.ObjectNew.(*corev1.ConfigMap).Data)
 	case *corev1.Secret:
-		return !reflect.DeepEqual(v.Data, e.ObjectNew.(*corev1.Secret).Data)
+		// the labels select the default credentials of the namespace
+		newSecret := e.ObjectNew.(*corev1.Secret)
+		return !reflect.DeepEqual(v.Data, newSecret.Data) || !reflect.DeepEqual(v.Labels, newSecret.Labels)
 	case *v1.AtlasTeam:
 		return !reflect.DeepEqual(v.Spec, e.ObjectNew.(*v1.AtlasTeam).Spec)
 	}
 	return true
 }
 
-func (c *ResourcesHandler) doHandle(namespace, name, kind string, q workqueue.RateLimitingInterface) {
-	watchedResource := WatchedObject{
-		ResourceKind: kind,
-		Resource:     types.NamespacedName{Name: name, Namespace: namespace},
+func (c *ResourcesHandler) doHandle(watched client.ObjectKey, q workqueue.RateLimitingInterface) {
+	// The handlers don't get a context, the lookup is served by the cache and doesn't block
+	dependants, err := c.Dependants(context.Background(), watched)
+	if err != nil {
+		zap.S().Errorf("resource watcher: failed to find the resources depending on %s (%s): %s", watched, c.ResourceKind, err)
+		return
 	}
-	for k := range c.TrackedResources[watchedResource] {
-		zap.S().Infof("%s has been modified -> triggering reconciliation for the %s", watchedResource, k)
+	for _, k := range dependants {
+		zap.S().Infof("%s (%s) has been modified -> triggering reconciliation for the %s", watched, c.ResourceKind, k)
 		q.Add(reconcile.Request{NamespacedName: k})
 	}
 }
 
-// Delete (Seems we don't need to react on watched resources removal..)
-func (c *ResourcesHandler) Delete(event.DeleteEvent, workqueue.RateLimitingInterface) {}
+// Delete handles the Delete event for the resource, so that the dependant resources stop using it. For example, the
+// projects inheriting the default credentials of the namespace fall back to the global ones once they are removed.
+func (c *ResourcesHandler) Delete(e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	c.doHandle(kube.ObjectKeyFromObject(e.Object), q)
+}
 
 func (c *ResourcesHandler) Generic(e event.GenericEvent, w workqueue.RateLimitingInterface) {
 }
@@ -1,6 +1,7 @@
 package watch
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,7 +19,7 @@ import (
 func TestHandleCreate(t *testing.T) {
 	t.Run("Create event is not handled", func(t *testing.T) {
 		secret := secretForTesting("testSecret")
-		handler := NewSecretHandler(make(map[WatchedObject]map[client.ObjectKey]bool))
+		handler := NewSecretHandler(dependantsOf(secretForTesting("someOtherSecret"), kube.ObjectKey("ns", "testAtlasProject")))
 		createEvent := event.CreateEvent{Object: secret}
 		queue := controllertest.Queue{Interface: workqueue.New()}
 
@@ -28,7 +29,7 @@ func TestHandleCreate(t *testing.T) {
 	t.Run("Create event is handled", func(t *testing.T) {
 		secret := secretForTesting("testSecret")
 		dependentResourceKey := kube.ObjectKey("ns", "testAtlasProject")
-		handler := NewSecretHandler(watchedResourcesMap(secret, dependentResourceKey))
+		handler := NewSecretHandler(dependantsOf(secret, dependentResourceKey))
 
 		createEvent := event.CreateEvent{Object: secret}
 		queue := controllertest.Queue{Interface: workqueue.New()}
@@ -52,7 +53,7 @@ func TestHandleUpdate(t *testing.T) {
 		oldSecret := secretForTesting("testSecret")
 		newSecret := oldSecret.DeepCopy()
 		newSecret.Data["secondKey"] = []byte("secondValue")
-		handler := NewSecretHandler(watchedResourcesMap(watchedSecret, dependentResourceKey))
+		handler := NewSecretHandler(dependantsOf(watchedSecret, dependentResourceKey))
 		updateEvent := event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: newSecret}
 		queue := controllertest.Queue{Interface: workqueue.New()}
 
@@ -67,7 +68,7 @@ func TestHandleUpdate(t *testing.T) {
 		newSecret := oldSecret.DeepCopy()
 		newSecret.Data["secondKey"] = []byte("secondValue")
 
-		handler := NewSecretHandler(watchedResourcesMap(secret, dependentResourceKey))
+		handler := NewSecretHandler(dependantsOf(secret, dependentResourceKey))
 
 		updateEvent := event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: newSecret}
 		queue := controllertest.Queue{Interface: workqueue.New()}
@@ -81,6 +82,34 @@ func TestHandleUpdate(t *testing.T) {
 	})
 }
 
+func TestHandleDelete(t *testing.T) {
+	t.Run("Delete event is not handled", func(t *testing.T) {
+		secret := secretForTesting("testSecret")
+		handler := NewSecretHandler(dependantsOf(secretForTesting("someOtherSecret"), kube.ObjectKey("ns", "testAtlasProject")))
+		deleteEvent := event.DeleteEvent{Object: secret}
+		queue := controllertest.Queue{Interface: workqueue.New()}
+
+		handler.Delete(deleteEvent, &queue)
+		assert.Zero(t, queue.Len())
+	})
+	t.Run("Delete event is handled", func(t *testing.T) {
+		secret := secretForTesting("testSecret")
+		dependentResourceKey := kube.ObjectKey("ns", "testAtlasProject")
+		handler := NewSecretHandler(dependantsOf(secret, dependentResourceKey))
+
+		deleteEvent := event.DeleteEvent{Object: secret}
+		queue := controllertest.Queue{Interface: workqueue.New()}
+
+		handler.Delete(deleteEvent, &queue)
+		assert.Equal(t, queue.Len(), 1)
+
+		enqueued, _ := queue.Get()
+
+		// The "dependent" resource is reconciled to stop using the removed Secret
+		assert.Equal(t, reconcile.Request{NamespacedName: dependentResourceKey}, enqueued)
+	})
+}
+
 func TestShouldHandleUpdate(t *testing.T) {
 	t.Run("Update shouldn't happen if Secrets data hasn't changed", func(t *testing.T) {
 		oldObj := secretForTesting("testValue")
@@ -95,6 +124,13 @@ func TestShouldHandleUpdate(t *testing.T) {
 		newObj.ObjectMeta.ResourceVersion = "4243"
 		newObj.Data["secondKey"] = []byte("secondValue")
 
+		assert.True(t, shouldHandleUpdate(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}))
+	})
+	t.Run("Update should happen if the labels have changed for Secret", func(t *testing.T) {
+		oldObj := secretForTesting("testValue")
+		newObj := oldObj.DeepCopy()
+		newObj.ObjectMeta.Labels = map[string]string{"atlas.mongodb.com/default-credentials": "true"}
+
 		assert.True(t, shouldHandleUpdate(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}))
 	})
 }
@@ -112,9 +148,11 @@ func secretForTesting(name string) *corev1.Secret {
 	}
 }
 
-func watchedResourcesMap(watched *corev1.Secret, dependent client.ObjectKey) map[WatchedObject]map[client.ObjectKey]bool {
-	watchedResources := make(map[WatchedObject]map[client.ObjectKey]bool)
-	watchedObject := WatchedObject{ResourceKind: watched.GetObjectKind().GroupVersionKind().Kind, Resource: kube.ObjectKeyFromObject(watched)}
-	watchedResources[watchedObject] = map[client.ObjectKey]bool{dependent: true}
-	return watchedResources
+func dependantsOf(watched *corev1.Secret, dependant client.ObjectKey) DependantsFunc {
+	return func(_ context.Context, key client.ObjectKey) ([]client.ObjectKey, error) {
+		if key != kube.ObjectKeyFromObject(watched) {
+			return nil, nil
+		}
+		return []client.ObjectKey{dependant}, nil
+	}
 }
@@ -0,0 +1,142 @@
+package workflow
+
+import (
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+const (
+	// MaxTransientRetry caps the delay between the retries of the transient failures by default
+	MaxTransientRetry = 10 * time.Minute
+	// PermanentRetry is the delay before the first retry of a permanent failure by default
+	PermanentRetry = time.Minute
+	// MaxPermanentRetry caps the delay between the retries of the permanent failures by default
+	MaxPermanentRetry = time.Hour
+)
+
+// RetryIntervals are the intervals between the retries of the failed reconciliations. The interval doubles on each
+// attempt, starting from the initial one up to the maximum.
+type RetryIntervals struct {
+	Transient    time.Duration
+	MaxTransient time.Duration
+	Permanent    time.Duration
+	MaxPermanent time.Duration
+}
+
+// DefaultRetryIntervals returns the retry intervals used unless configured otherwise
+func DefaultRetryIntervals() RetryIntervals {
+	return RetryIntervals{
+		Transient:    DefaultRetry,
+		MaxTransient: MaxTransientRetry,
+		Permanent:    PermanentRetry,
+		MaxPermanent: MaxPermanentRetry,
+	}
+}
+
+var retryIntervals atomic.Pointer[RetryIntervals]
+
+func init() {
+	ConfigureRetry(DefaultRetryIntervals())
+}
+
+// ConfigureRetry sets the retry intervals of the failed reconciliations. It's safe to be called while the
+// reconciliations are running, the new intervals apply to the next failures.
+func ConfigureRetry(intervals RetryIntervals) {
+	retryIntervals.Store(&intervals)
+}
+
+// CurrentRetryIntervals returns the retry intervals in use
+func CurrentRetryIntervals() RetryIntervals {
+	return *retryIntervals.Load()
+}
+
+// failurePriority orders the failures of a reconciliation, the one with the highest priority drives the retry:
+// a resource waiting for Atlas keeps being polled even if some other part of it failed
+var failurePriority = map[Failure]int{
+	FailurePermanent:  1,
+	FailureTransient:  2,
+	FailureInProgress: 3,
+}
+
+// SetLastRetry sets the retry recorded in the status by the previous reconciliation. It must be nil if the spec
+// changed since then, so that the backoff starts over.
+func (c *Context) SetLastRetry(retry *status.Retry) {
+	c.lastRetry = retry
+}
+
+// recordFailure keeps the failure driving the retry of the reconciliation. The results requesting a specific retry
+// are not backed off.
+func (c *Context) recordFailure(result Result) {
+	if result.IsOk() || result.requeueAfter != DefaultRetry {
+		return
+	}
+	if c.failure == nil || failurePriority[result.failure] > failurePriority[c.failure.failure] {
+		c.failure = &result
+	}
+}
+
+// Retry returns the retry to be recorded in the status: the previous one increased if the reconciliation failed,
+// nil if it succeeded or waits for Atlas, or the previous one if the outcome isn't known yet.
+func (c *Context) Retry() *status.Retry {
+	if c.failure == nil {
+		if c.succeeded {
+			return nil
+		}
+		return c.lastRetry
+	}
+	attempts := c.attempts()
+	if attempts == 0 {
+		return nil
+	}
+	return &status.Retry{
+		Attempts:      attempts,
+		NextRetryTime: metav1.NewTime(time.Now().Add(retryDelay(c.failure.failure, attempts))),
+	}
+}
+
+// ApplyBackoff replaces the fixed requeue of a failed reconciliation with the exponential backoff.
+// The reconciliations waiting for Atlas keep the requeue requested. A failure returned without setting
+// a condition is recorded from the result as a transient one. It must be called before the status is updated
+// so that the retry recorded there matches the requeue.
+func (c *Context) ApplyBackoff(result reconcile.Result) reconcile.Result {
+	if c.failure == nil && !c.succeeded && result.RequeueAfter == DefaultRetry {
+		c.recordFailure(TerminateSilently())
+	}
+	if c.failure == nil || result.RequeueAfter <= 0 {
+		return result
+	}
+	if attempts := c.attempts(); attempts > 0 {
+		result.RequeueAfter = retryDelay(c.failure.failure, attempts)
+	}
+	return result
+}
+
+func (c *Context) attempts() int {
+	if c.failure.failure == FailureInProgress {
+		return 0
+	}
+	if c.lastRetry == nil {
+		return 1
+	}
+	return c.lastRetry.Attempts + 1
+}
+
+func retryDelay(failure Failure, attempts int) time.Duration {
+	intervals := CurrentRetryIntervals()
+	delay, maxDelay := intervals.Transient, intervals.MaxTransient
+	if failure == FailurePermanent {
+		delay, maxDelay = intervals.Permanent, intervals.MaxPermanent
+	}
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
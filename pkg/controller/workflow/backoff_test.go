@@ -0,0 +1,132 @@
+package workflow
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+func TestFailure(t *testing.T) {
+	assert.Equal(t, Failure(""), OK().Failure())
+	assert.Equal(t, FailureTransient, Terminate(Internal, "error").Failure())
+	assert.Equal(t, FailurePermanent, Terminate(AtlasCredentialsNotProvided, "no credentials").Failure())
+	assert.Equal(t, FailurePermanent, Terminate(Internal, "error").Permanent().Failure())
+	assert.Equal(t, FailureInProgress, InProgress(DeploymentCreating, "creating").Failure())
+
+	assert.Equal(t, FailurePermanent, TerminateWithError(DeploymentNotCreatedInAtlas, atlasError(http.StatusBadRequest)).Failure())
+	assert.Equal(t, FailureTransient, TerminateWithError(DeploymentNotCreatedInAtlas, atlasError(http.StatusServiceUnavailable)).Failure())
+	assert.Equal(t, FailureTransient, TerminateWithError(DeploymentNotCreatedInAtlas, errors.New("timeout")).Failure())
+}
+
+func atlasError(code int) error {
+	request, _ := http.NewRequest(http.MethodPost, "https://cloud.mongodb.com/api/atlas/v1.0/groups", nil)
+	return &mongodbatlas.ErrorResponse{
+		Response: &http.Response{StatusCode: code, Request: request},
+		HTTPCode: code,
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryDelay(FailureTransient, 1))
+	assert.Equal(t, 20*time.Second, retryDelay(FailureTransient, 2))
+	assert.Equal(t, 80*time.Second, retryDelay(FailureTransient, 4))
+	assert.Equal(t, MaxTransientRetry, retryDelay(FailureTransient, 100))
+	assert.Equal(t, time.Minute, retryDelay(FailurePermanent, 1))
+	assert.Equal(t, 4*time.Minute, retryDelay(FailurePermanent, 3))
+	assert.Equal(t, MaxPermanentRetry, retryDelay(FailurePermanent, 100))
+
+	t.Run("Configured intervals", func(t *testing.T) {
+		defer ConfigureRetry(DefaultRetryIntervals())
+		ConfigureRetry(RetryIntervals{Transient: time.Second, MaxTransient: 3 * time.Second, Permanent: time.Hour, MaxPermanent: time.Hour})
+
+		assert.Equal(t, 2*time.Second, retryDelay(FailureTransient, 2))
+		assert.Equal(t, 3*time.Second, retryDelay(FailureTransient, 3))
+		assert.Equal(t, time.Hour, retryDelay(FailurePermanent, 5))
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	defaultRequeue := reconcile.Result{RequeueAfter: DefaultRetry}
+
+	t.Run("Transient failure increases the attempts", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 2})
+		ctx.SetConditionFromResult(status.ReadyType, Terminate(Internal, "error"))
+
+		retry := ctx.Retry()
+		require.NotNil(t, retry)
+		assert.Equal(t, 3, retry.Attempts)
+		assert.WithinDuration(t, time.Now().Add(40*time.Second), retry.NextRetryTime.Time, time.Second)
+		assert.Equal(t, 40*time.Second, ctx.ApplyBackoff(defaultRequeue).RequeueAfter)
+	})
+
+	t.Run("First permanent failure", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetConditionFromResult(status.ProjectReadyType, Terminate(AtlasCredentialsNotProvided, "no credentials"))
+
+		assert.Equal(t, 1, ctx.Retry().Attempts)
+		assert.Equal(t, PermanentRetry, ctx.ApplyBackoff(defaultRequeue).RequeueAfter)
+	})
+
+	t.Run("In progress resource is polled and resets the attempts", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 5})
+		ctx.SetConditionFromResult(status.ProjectReadyType, Terminate(AtlasCredentialsNotProvided, "no credentials"))
+		ctx.SetConditionFromResult(status.DeploymentReadyType, InProgress(DeploymentCreating, "creating"))
+
+		assert.Nil(t, ctx.Retry())
+		assert.Equal(t, defaultRequeue, ctx.ApplyBackoff(defaultRequeue))
+	})
+
+	t.Run("Transient failure takes precedence over permanent one", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetConditionFromResult(status.IPAccessListReadyType, Terminate(ProjectIPAccessInvalid, "invalid"))
+		ctx.SetConditionFromResult(status.MaintenanceWindowReadyType, Terminate(ProjectWindowNotObtainedFromAtlas, "error"))
+
+		assert.Equal(t, DefaultRetry, ctx.ApplyBackoff(defaultRequeue).RequeueAfter)
+	})
+
+	t.Run("Explicit retry is not backed off", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 2})
+		result := Terminate(Internal, "error").WithRetry(time.Minute)
+		ctx.SetConditionFromResult(status.ReadyType, result)
+
+		assert.Equal(t, time.Minute, ctx.ApplyBackoff(result.ReconcileResult()).RequeueAfter)
+		assert.Equal(t, 2, ctx.Retry().Attempts)
+	})
+
+	t.Run("Successful reconciliation removes the retry", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 2})
+		ctx.SetConditionFromResult(status.ProjectReadyType, Terminate(Internal, "error"))
+		ctx.SetConditionTrue(status.ReadyType)
+
+		assert.Nil(t, ctx.Retry())
+		assert.Equal(t, reconcile.Result{}, ctx.ApplyBackoff(reconcile.Result{}))
+	})
+
+	t.Run("Failure returned without a condition is backed off", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 2})
+
+		assert.Equal(t, 40*time.Second, ctx.ApplyBackoff(Terminate(Internal, "error").ReconcileResult()).RequeueAfter)
+		assert.Equal(t, 3, ctx.Retry().Attempts)
+	})
+
+	t.Run("Retry is kept until the outcome is known", func(t *testing.T) {
+		ctx := NewContext(zap.S(), nil)
+		ctx.SetLastRetry(&status.Retry{Attempts: 2})
+
+		assert.Equal(t, 2, ctx.Retry().Attempts)
+	})
+}
@@ -1,19 +1,26 @@
 package workflow
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 )
 
 // Context is a container for some information that is needed on all levels of function calls during reconciliation.
 // It's mutable by design.
-// Note, that it's completely different from the Go Context
+// Note, that it's completely different from the Go Context, though it carries the one of the reconciliation
 type Context struct {
+	// Context is the Go context of the reconciliation. It carries the trace of the reconciliation to the Atlas
+	// API calls.
+	Context context.Context
+
 	// Log is the root logger used in the reconciliation. Used just for convenience to avoid passing log to each
 	// method.
 	// Is not supposed to be mutated!
@@ -35,14 +42,21 @@ type Context struct {
 	// or unexpected (any errors)
 	lastConditionWarn bool
 
-	// A list of sub-resources to add to a resource watcher after the Reconcile loop
-	resourcesToWatch []watch.WatchedObject
+	// lastRetry is the retry recorded in the status by the previous reconciliation
+	lastRetry *status.Retry
+
+	// failure is the failed result driving the retry of the reconciliation
+	failure *Result
+
+	// succeeded indicates if the resource became ready
+	succeeded bool
 }
 
 func NewContext(log *zap.SugaredLogger, conditions []status.Condition) *Context {
 	return &Context{
-		status: NewStatus(conditions),
-		Log:    log,
+		Context: context.Background(),
+		status:  NewStatus(conditions),
+		Log:     log,
 	}
 }
 
@@ -74,6 +88,10 @@ func (c *Context) EnsureStatusOption(option status.Option) *Context {
 func (c *Context) EnsureCondition(condition status.Condition) *Context {
 	c.status.EnsureCondition(condition)
 	c.lastCondition = &condition
+	if condition.Type == status.ReadyType && condition.Status == corev1.ConditionTrue {
+		c.failure = nil
+		c.succeeded = true
+	}
 	return c
 }
 
@@ -89,6 +107,7 @@ func (c *Context) SetConditionFromResult(conditionType status.ConditionType, res
 	}
 	c.EnsureCondition(condition)
 	c.lastConditionWarn = result.warning
+	c.recordFailure(result)
 	return c
 }
 
@@ -117,15 +136,47 @@ func (c *Context) SetConditionTrue(conditionType status.ConditionType) *Context
 	return c
 }
 
-func (c *Context) UnsetCondition(conditionType status.ConditionType) *Context {
-	c.status.RemoveCondition(conditionType)
+// SetPlan reports the changes a reconciliation in plan mode would apply to Atlas.
+// An empty plan means Atlas already matches the spec, unless some sections of the resource were not planned: the
+// reason is ChangesPartiallyPlanned then and the message lists the sections which may be changed as well.
+func (c *Context) SetPlan(plan string, unplanned ...string) *Context {
+	condition := status.Condition{
+		Type:    status.ReconciliationPlannedType,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(NoChangesPlanned),
+		Message: "no changes would be applied to Atlas",
+	}
+	if plan != "" {
+		condition.Reason = string(ChangesPlanned)
+		condition.Message = plan
+	}
+	if len(unplanned) > 0 {
+		notPlanned := fmt.Sprintf("not planned (may be changed in Atlas as well): %s", strings.Join(unplanned, ", "))
+		if plan == "" {
+			condition.Message = "no changes would be applied to the planned sections in Atlas\n" + notPlanned
+		} else {
+			condition.Message = plan + "\n" + notPlanned
+		}
+		condition.Reason = string(ChangesPartiallyPlanned)
+	}
+	c.EnsureCondition(condition)
+	c.lastConditionWarn = false
 	return c
 }
 
-func (c *Context) AddResourcesToWatch(resources ...watch.WatchedObject) {
-	c.resourcesToWatch = append(c.resourcesToWatch, resources...)
+// SetDrift reports the fields of the Atlas resource changed outside the operator since the last applied configuration.
+func (c *Context) SetDrift(message string) *Context {
+	c.EnsureCondition(status.Condition{
+		Type:    status.DriftedType,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(AtlasDriftDetected),
+		Message: message,
+	})
+	c.lastConditionWarn = true
+	return c
 }
 
-func (c *Context) ListResourcesToWatch() []watch.WatchedObject {
-	return c.resourcesToWatch
+func (c *Context) UnsetCondition(conditionType status.ConditionType) *Context {
+	c.status.RemoveCondition(conditionType)
+	return c
 }
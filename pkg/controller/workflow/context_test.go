@@ -0,0 +1,40 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+)
+
+func TestSetPlan(t *testing.T) {
+	plannedCondition := func(ctx *Context) status.Condition {
+		condition, found := ctx.GetCondition(status.ReconciliationPlannedType)
+		require.True(t, found)
+		return condition
+	}
+
+	t.Run("No changes planned", func(t *testing.T) {
+		condition := plannedCondition(NewContext(zap.S(), nil).SetPlan(""))
+		assert.Equal(t, string(NoChangesPlanned), condition.Reason)
+		assert.Equal(t, "no changes would be applied to Atlas", condition.Message)
+	})
+	t.Run("Changes planned", func(t *testing.T) {
+		condition := plannedCondition(NewContext(zap.S(), nil).SetPlan("entry would be created"))
+		assert.Equal(t, string(ChangesPlanned), condition.Reason)
+		assert.Equal(t, "entry would be created", condition.Message)
+	})
+	t.Run("No changes planned with unplanned sections", func(t *testing.T) {
+		condition := plannedCondition(NewContext(zap.S(), nil).SetPlan("", "teams", "auditing"))
+		assert.Equal(t, string(ChangesPartiallyPlanned), condition.Reason)
+		assert.Equal(t, "no changes would be applied to the planned sections in Atlas\nnot planned (may be changed in Atlas as well): teams, auditing", condition.Message)
+	})
+	t.Run("Changes planned with unplanned sections", func(t *testing.T) {
+		condition := plannedCondition(NewContext(zap.S(), nil).SetPlan("entry would be created", "teams"))
+		assert.Equal(t, string(ChangesPartiallyPlanned), condition.Reason)
+		assert.Equal(t, "entry would be created\nnot planned (may be changed in Atlas as well): teams", condition.Message)
+	})
+}
@@ -13,6 +13,13 @@ const (
 	AtlasFinalizerNotSet          ConditionReason = "AtlasFinalizerNotSet"
 	AtlasFinalizerNotRemoved      ConditionReason = "AtlasFinalizerNotRemoved"
 	AtlasDeletionProtection       ConditionReason = "AtlasDeletionProtection"
+	ChangesPlanned                ConditionReason = "ChangesPlanned"
+	NoChangesPlanned              ConditionReason = "NoChangesPlanned"
+	ChangesPartiallyPlanned       ConditionReason = "ChangesPartiallyPlanned"
+	AtlasDriftDetected            ConditionReason = "AtlasDriftDetected"
+	AtlasAPIKeyInvalid            ConditionReason = "AtlasAPIKeyInvalid"
+	AtlasAPIKeyMissingRole        ConditionReason = "AtlasAPIKeyMissingRole"
+	AtlasAPIAccessNotAllowed      ConditionReason = "AtlasAPIAccessNotAllowed"
 )
 
 // Atlas Project reasons
@@ -66,7 +73,10 @@ const (
 	DatabaseUserStaleConnectionSecrets      ConditionReason = "DatabaseUserStaleConnectionSecrets"
 	DatabaseUserDeploymentAppliedChanges    ConditionReason = "DeploymentAppliedDatabaseUsersChanges"
 	DatabaseUserInvalidSpec                 ConditionReason = "DatabaseUserInvalidSpec"
+	DatabaseUserInvalidTargets              ConditionReason = "DatabaseUserInvalidTargets"
 	DatabaseUserExpired                     ConditionReason = "DatabaseUserExpired"
+	DatabaseUserX509CertificateNotIssued    ConditionReason = "DatabaseUserX509CertificateNotIssued"
+	DatabaseUserPasswordNotGenerated        ConditionReason = "DatabaseUserPasswordNotGenerated"
 )
 
 // Atlas Data Federation reasons
@@ -84,3 +94,9 @@ const (
 	TeamUsersNotReady     ConditionReason = "TeamUsersNotReady"
 	TeamDoesNotExist      ConditionReason = "TeamDoesNotExist"
 )
+
+// Atlas Operator Config reasons
+const (
+	OperatorConfigInvalid   ConditionReason = "OperatorConfigInvalid"
+	OperatorRestartRequired ConditionReason = "OperatorRestartRequired"
+)
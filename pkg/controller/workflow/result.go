@@ -1,8 +1,11 @@
 package workflow
 
 import (
+	"errors"
+	"net/http"
 	"time"
 
+	"go.mongodb.org/atlas/mongodbatlas"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -19,6 +22,37 @@ type Result struct {
 	// warning indicates if the reconciliation hasn't ended the expected way. Most of all this may happens in case of
 	// an error
 	warning bool
+	// failure classifies the result of the reconciliation which cannot proceed, it drives the retries
+	failure Failure
+}
+
+// Failure classifies why the reconciliation cannot proceed
+type Failure string
+
+const (
+	// FailureTransient is an error which may go away by itself, the reconciliation is retried with an
+	// exponential backoff
+	FailureTransient Failure = "Transient"
+	// FailureInProgress means the reconciliation waits for Atlas, it's polled at the requested interval
+	FailureInProgress Failure = "InProgress"
+	// FailurePermanent is an error which requires the user to change the spec or the credentials, the
+	// reconciliation is retried with a longer exponential backoff
+	FailurePermanent Failure = "Permanent"
+)
+
+// permanentReasons are the reasons of the terminated reconciliations which require a change of the user
+var permanentReasons = map[ConditionReason]bool{
+	AtlasCredentialsNotProvided:   true,
+	AtlasResourceVersionMismatch:  true,
+	AtlasResourceVersionIsInvalid: true,
+	AtlasDeletionProtection:       true,
+	ProjectIPAccessInvalid:        true,
+	ProjectWindowInvalid:          true,
+	DatabaseUserInvalidSpec:       true,
+	DatabaseUserInvalidTargets:    true,
+	DatabaseUserExpired:           true,
+	TeamInvalidSpec:               true,
+	OperatorConfigInvalid:         true,
 }
 
 // OK indicates that the reconciliation logic can proceed further
@@ -34,13 +68,32 @@ func OK() Result {
 // 'reason' and 'message' indicate the error state and are supposed to be reflected in the `conditions` for the
 // reconciled Custom Resource.
 func Terminate(reason ConditionReason, message string) Result {
+	failure := FailureTransient
+	if permanentReasons[reason] {
+		failure = FailurePermanent
+	}
 	return Result{
 		terminated:   true,
 		requeueAfter: DefaultRetry,
 		reason:       reason,
 		message:      message,
 		warning:      true,
+		failure:      failure,
+	}
+}
+
+// TerminateWithError is Terminate with the message of the error. The errors returned by Atlas because of the request
+// or the credentials make the failure permanent.
+func TerminateWithError(reason ConditionReason, err error) Result {
+	result := Terminate(reason, err.Error())
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) {
+		switch apiError.HTTPCode {
+		case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+			return result.Permanent()
+		}
 	}
+	return result
 }
 
 // InProgress indicates that the reconciliation logic cannot proceed and needs to be finished (and possibly requeued).
@@ -53,13 +106,20 @@ func InProgress(reason ConditionReason, message string) Result {
 		reason:       reason,
 		message:      message,
 		warning:      false,
+		failure:      FailureInProgress,
 	}
 }
 
 // TerminateSilently indicates that the reconciliation logic cannot proceed and needs to be finished (and possibly requeued)
 // The status of the reconciled Custom Resource is not supposed to be updated.
 func TerminateSilently() Result {
-	return Result{terminated: true, requeueAfter: DefaultRetry}
+	return Result{terminated: true, requeueAfter: DefaultRetry, failure: FailureTransient}
+}
+
+// Permanent marks the failure as caused by the user, who needs to fix the spec or the credentials
+func (r Result) Permanent() Result {
+	r.failure = FailurePermanent
+	return r
 }
 
 func (r Result) WithRetry(retry time.Duration) Result {
@@ -91,6 +151,14 @@ func (r Result) IsInProgress() bool {
 	return r.terminated && !r.warning
 }
 
+// Failure returns the classification of the failure, it's empty if the reconciliation can proceed
+func (r Result) Failure() Failure {
+	if r.IsOk() {
+		return ""
+	}
+	return r.failure
+}
+
 func (r Result) GetMessage() string {
 	return r.message
 }
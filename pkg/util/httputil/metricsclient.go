@@ -0,0 +1,137 @@
+package httputil
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// APIMetrics holds the collectors recording the requests sent by the clients decorated with the Metrics option
+type APIMetrics struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+var apiMetricsLabels = []string{"endpoint", "method", "code", "controller"}
+
+// NewAPIMetrics creates the request counters and latency histograms. Their names are prefixed with 'namespace'.
+func NewAPIMetrics(namespace string) *APIMetrics {
+	return &APIMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "api_requests_total",
+			Help:      "Total number of requests sent to the API by endpoint, method, status code and controller",
+		}, apiMetricsLabels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "api_request_errors_total",
+			Help:      "Total number of requests to the API which failed or got an error status code",
+		}, apiMetricsLabels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "api_request_duration_seconds",
+			Help:      "Latency of the requests sent to the API",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, apiMetricsLabels),
+	}
+}
+
+// Collectors returns the collectors to be registered in a Prometheus registry
+func (m *APIMetrics) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.requests, m.errors, m.duration}
+}
+
+// Metrics is the option recording every request sent by an http Client to the metrics, labelled with the 'controller'
+func Metrics(metrics *APIMetrics, controller string) ClientOpt {
+	return func(c *http.Client) error {
+		c.Transport = &metricsRoundTripper{rt: c.Transport, metrics: metrics, controller: controller}
+		return nil
+	}
+}
+
+type metricsRoundTripper struct {
+	rt         http.RoundTripper
+	metrics    *APIMetrics
+	controller string
+}
+
+func (m *metricsRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	startTime := time.Now()
+	response, err := m.rt.RoundTrip(request)
+	duration := time.Since(startTime)
+
+	code := "error"
+	if err == nil && response != nil {
+		code = strconv.Itoa(response.StatusCode)
+	}
+	labels := prometheus.Labels{
+		"endpoint":   EndpointTemplate(request.URL.Path),
+		"method":     request.Method,
+		"code":       code,
+		"controller": m.controller,
+	}
+	m.metrics.requests.With(labels).Inc()
+	m.metrics.duration.With(labels).Observe(duration.Seconds())
+	if err != nil || response == nil || response.StatusCode >= http.StatusBadRequest {
+		m.metrics.errors.With(labels).Inc()
+	}
+
+	return response, err
+}
+
+// pathParameters lists the path segments of the Atlas API followed by parameters, with the names of the parameters
+var pathParameters = map[string][]string{
+	"groups":          {"{groupId}"},
+	"orgs":            {"{orgId}"},
+	"byName":          {"{name}"},
+	"clusters":        {"{clusterName}"},
+	"serverless":      {"{instanceName}"},
+	"databaseUsers":   {"{databaseName}", "{username}"},
+	"accessList":      {"{entry}"},
+	"alertConfigs":    {"{alertConfigId}"},
+	"teams":           {"{teamId}"},
+	"users":           {"{userId}"},
+	"apiKeys":         {"{apiUserId}"},
+	"invites":         {"{invitationId}"},
+	"integrations":    {"{integrationType}"},
+	"roles":           {"{roleName}"},
+	"dataFederation":  {"{tenantName}"},
+	"privateEndpoint": {"{cloudProvider}"},
+	"endpointService": {"{endpointServiceId}"},
+	"endpoint":        {"{endpointId}"},
+	"containers":      {"{containerId}"},
+	"peers":           {"{peerId}"},
+	"snapshots":       {"{snapshotId}"},
+	"restoreJobs":     {"{jobId}"},
+	"indexes":         {"{indexId}"},
+	"onlineArchives":  {"{archiveId}"},
+	"processes":       {"{processId}"},
+}
+
+var objectIDRegex = regexp.MustCompile("^[0-9a-fA-F]{24}$")
+
+// EndpointTemplate replaces the parameters in an Atlas API path with their names, so that the requests to the same
+// endpoint share the metric labels. For example "/api/atlas/v1.0/groups/5f1b.../clusters/test" becomes
+// "/api/atlas/v1.0/groups/{groupId}/clusters/{clusterName}".
+func EndpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments); i++ {
+		if objectIDRegex.MatchString(segments[i]) {
+			segments[i] = "{id}"
+			continue
+		}
+		for _, parameter := range pathParameters[segments[i]] {
+			if i+1 >= len(segments) || segments[i+1] == "" || segments[i+1] == "byName" {
+				break
+			}
+			i++
+			segments[i] = parameter
+		}
+	}
+	return strings.Join(segments, "/")
+}
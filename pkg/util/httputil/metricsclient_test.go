@@ -0,0 +1,75 @@
+package httputil
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/api/atlas/v1.0/groups":                                                                                  "/api/atlas/v1.0/groups",
+		"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678":                                                         "/api/atlas/v1.0/groups/{groupId}",
+		"/api/atlas/v1.0/groups/byName/my-project":                                                                "/api/atlas/v1.0/groups/byName/{name}",
+		"/api/atlas/v1.5/groups/5f1b3a8c9d2e4f0012345678/clusters/test-cluster":                                   "/api/atlas/v1.5/groups/{groupId}/clusters/{clusterName}",
+		"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678/clusters/":                                               "/api/atlas/v1.0/groups/{groupId}/clusters/",
+		"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678/databaseUsers/admin/user1":                               "/api/atlas/v1.0/groups/{groupId}/databaseUsers/{databaseName}/{username}",
+		"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678/clusters/test/backup/snapshots/6f1b3a8c9d2e4f0012345678": "/api/atlas/v1.0/groups/{groupId}/clusters/{clusterName}/backup/snapshots/{snapshotId}",
+		"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678/cloudProviderAccess/6f1b3a8c9d2e4f0012345678":            "/api/atlas/v1.0/groups/{groupId}/cloudProviderAccess/{id}",
+	} {
+		assert.Equal(t, expected, EndpointTemplate(path), path)
+	}
+}
+
+type failingTripper struct{}
+
+func (*failingTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	metrics := NewAPIMetrics("test")
+	httpClient, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, Metrics(metrics, "AtlasProject"))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		response, err := httpClient.Get(server.URL + "/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678")
+		require.NoError(t, err)
+		response.Body.Close()
+	}
+	request, err := http.NewRequest(http.MethodDelete, server.URL+"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678", nil)
+	require.NoError(t, err)
+	response, err := httpClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	failingClient, err := DecorateClient(&http.Client{Transport: &failingTripper{}}, Metrics(metrics, "AtlasDeployment"))
+	require.NoError(t, err)
+	_, err = failingClient.Get(server.URL + "/api/atlas/v1.0/groups")
+	require.Error(t, err)
+
+	endpoint := "/api/atlas/v1.0/groups/{groupId}"
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues(endpoint, http.MethodGet, "200", "AtlasProject")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(endpoint, http.MethodDelete, "404", "AtlasProject")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("/api/atlas/v1.0/groups", http.MethodGet, "error", "AtlasDeployment")))
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.errors.WithLabelValues(endpoint, http.MethodGet, "200", "AtlasProject")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.errors.WithLabelValues(endpoint, http.MethodDelete, "404", "AtlasProject")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.errors.WithLabelValues("/api/atlas/v1.0/groups", http.MethodGet, "error", "AtlasDeployment")))
+
+	assert.Equal(t, 3, testutil.CollectAndCount(metrics.duration))
+}
@@ -0,0 +1,29 @@
+package httputil
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OAuth2ClientCredentials is the option authenticating the requests of an http client with the access tokens obtained
+// by the OAuth 2.0 client credentials flow. The token is cached by the client and requested again once it expires.
+// The tokens are requested with the transport the client has before the option is applied.
+func OAuth2ClientCredentials(tokenURL, clientID, clientSecret string) ClientOpt {
+	return func(c *http.Client) error {
+		config := clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenURL,
+			AuthStyle:    oauth2.AuthStyleInHeader,
+		}
+		tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: c.Transport})
+		c.Transport = &oauth2.Transport{
+			Source: config.TokenSource(tokenCtx),
+			Base:   c.Transport,
+		}
+		return nil
+	}
+}
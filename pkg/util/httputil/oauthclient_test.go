@@ -0,0 +1,49 @@
+package httputil
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuth2ClientCredentials(t *testing.T) {
+	var tokenRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/oauth/token" {
+			clientID, clientSecret, ok := r.BasicAuth()
+			if !ok || clientID != "client-id" || clientSecret != "client-secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			n := tokenRequests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, n)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := DecorateClient(
+		&http.Client{Transport: http.DefaultTransport},
+		OAuth2ClientCredentials(server.URL+"/api/oauth/token", "client-id", "client-secret"),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		response, err := httpClient.Get(server.URL + "/api/atlas/v2/groups")
+		require.NoError(t, err)
+		response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	}
+	assert.Equal(t, int32(1), tokenRequests.Load(), "the token is cached")
+}
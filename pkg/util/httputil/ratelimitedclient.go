@@ -0,0 +1,246 @@
+package httputil
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryConfig defines how the idempotent requests are retried
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries of a request. Zero disables the retries
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, it doubles with every following retry
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used for the Atlas API
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 4,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// RateLimiter throttles the requests with a token bucket. The requests are also held back while the server
+// asks so with a 'Retry-After' header.
+type RateLimiter struct {
+	limiter *rate.Limiter
+
+	mu        sync.Mutex
+	notBefore time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing 'requestsPerSecond' requests on average with bursts of 'burst' requests.
+// A non-positive 'requestsPerSecond' disables the token bucket.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	limit := rate.Inf
+	if requestsPerSecond > 0 {
+		limit = rate.Limit(requestsPerSecond)
+	}
+	return &RateLimiter{limiter: rate.NewLimiter(limit, burst)}
+}
+
+// Wait blocks until a request can be sent or the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	delay := time.Until(l.notBefore)
+	l.mu.Unlock()
+
+	if err := sleep(ctx, delay); err != nil {
+		return err
+	}
+
+	return l.limiter.Wait(ctx)
+}
+
+// Pause holds back all the requests for the duration
+func (l *RateLimiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if notBefore := time.Now().Add(d); notBefore.After(l.notBefore) {
+		l.notBefore = notBefore
+	}
+}
+
+// RateLimiters keeps a RateLimiter per key so that the clients using the same key share the same rate limit
+type RateLimiters struct {
+	requestsPerSecond float64
+	burst             int
+
+	mu       sync.Mutex
+	limiters map[string]*RateLimiter
+}
+
+func NewRateLimiters(requestsPerSecond float64, burst int) *RateLimiters {
+	return &RateLimiters{
+		requestsPerSecond: requestsPerSecond,
+		burst:             burst,
+		limiters:          map[string]*RateLimiter{},
+	}
+}
+
+// Get returns the RateLimiter of the key, creating it if needed
+func (r *RateLimiters) Get(key string) *RateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = NewRateLimiter(r.requestsPerSecond, r.burst)
+		r.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// RateLimit is the option throttling the requests of an http Client with the limiter.
+// The idempotent requests failing with a network error, a 429 or a 5xx gateway status are retried with a jittered
+// exponential backoff, honoring the 'Retry-After' header sent by the server.
+func RateLimit(limiter *RateLimiter, retry RetryConfig) ClientOpt {
+	return func(c *http.Client) error {
+		c.Transport = &rateLimitedRoundTripper{rt: c.Transport, limiter: limiter, retry: retry}
+		return nil
+	}
+}
+
+type rateLimitedRoundTripper struct {
+	rt      http.RoundTripper
+	limiter *RateLimiter
+	retry   RetryConfig
+}
+
+func (r *rateLimitedRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	req := request
+	for attempt := 0; ; attempt++ {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		if attempt > 0 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = request.Clone(ctx)
+			req.Body = body
+		}
+
+		response, err := r.rt.RoundTrip(req)
+
+		retryAfter := retryAfterDelay(response)
+		if retryAfter > 0 {
+			r.limiter.Pause(retryAfter)
+		}
+
+		if attempt >= r.retry.MaxRetries || !isRetryable(request, response, err) {
+			return response, err
+		}
+
+		if response != nil {
+			// the connection can only be reused once the body is fully read
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		if err = sleep(ctx, r.backoff(attempt, retryAfter)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the retry, growing exponentially with a random jitter and never shorter than
+// the delay asked by the server
+func (r *rateLimitedRoundTripper) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := float64(r.retry.MinBackoff) * math.Pow(2, float64(attempt))
+	if maxDelay := float64(r.retry.MaxBackoff); r.retry.MaxBackoff > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	// jitter between 50% and 100% of the delay, so that concurrent clients don't retry together
+	delay = delay/2 + rand.Float64()*delay/2 //nolint:gosec // no need for a cryptographic random here
+
+	if d := time.Duration(delay); d > retryAfter {
+		return d
+	}
+	return retryAfter
+}
+
+func isRetryable(request *http.Request, response *http.Response, err error) bool {
+	if !isIdempotent(request) {
+		return false
+	}
+
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return request.Context().Err() == nil
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+func isIdempotent(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryAfterDelay parses the 'Retry-After' header, given either in seconds or as an HTTP date
+func retryAfterDelay(response *http.Response) time.Duration {
+	if response == nil {
+		return 0
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+package httputil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryConfig() RetryConfig {
+	return RetryConfig{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+}
+
+func testServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&calls, 1)
+		status := statuses[len(statuses)-1]
+		if int(call) <= len(statuses) {
+			status = statuses[call-1]
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func Test_RateLimitRetriesIdempotentRequests(t *testing.T) {
+	server, calls := testServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+	client, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, RateLimit(NewRateLimiter(0, 1), testRetryConfig()))
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, server.URL, bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "payload", string(body), "the body must be sent again on retries")
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func Test_RateLimitGivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := testServer(t, http.StatusBadGateway)
+	client, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, RateLimit(NewRateLimiter(0, 1), testRetryConfig()))
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func Test_RateLimitDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	server, calls := testServer(t, http.StatusServiceUnavailable, http.StatusOK)
+	client, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, RateLimit(NewRateLimiter(0, 1), testRetryConfig()))
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func Test_RateLimiterHonorsRetryAfter(t *testing.T) {
+	limiter := NewRateLimiter(0, 1)
+	limiter.Pause(50 * time.Millisecond)
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.Pause(time.Minute)
+	assert.Error(t, limiter.Wait(ctx))
+}
+
+func Test_RateLimitersAreSharedByKey(t *testing.T) {
+	limiters := NewRateLimiters(1, 1)
+
+	assert.Same(t, limiters.Get("org1"), limiters.Get("org1"))
+	assert.NotSame(t, limiters.Get("org1"), limiters.Get("org2"))
+}
+
+func Test_RetryAfterDelay(t *testing.T) {
+	newResponse := func(retryAfter string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{retryAfter}}}
+	}
+
+	assert.Equal(t, time.Duration(0), retryAfterDelay(nil))
+	assert.Equal(t, time.Duration(0), retryAfterDelay(&http.Response{}))
+	assert.Equal(t, 5*time.Second, retryAfterDelay(newResponse("5")))
+	assert.Equal(t, time.Duration(0), retryAfterDelay(newResponse("soon")))
+
+	delay := retryAfterDelay(newResponse(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)))
+	assert.Greater(t, delay, 50*time.Second)
+}
+
+func Test_Backoff(t *testing.T) {
+	rt := &rateLimitedRoundTripper{retry: RetryConfig{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}}
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		delay := rt.backoff(attempt, 0)
+		assert.GreaterOrEqual(t, delay, expected/2)
+		assert.LessOrEqual(t, delay, expected)
+	}
+
+	assert.Equal(t, time.Minute, rt.backoff(0, time.Minute))
+}
@@ -0,0 +1,72 @@
+package httputil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig is the configuration of the connection of an http Client to the server
+type TransportConfig struct {
+	// CABundle are the PEM encoded certificates trusted in addition to the system ones
+	CABundle []byte
+	// ProxyURL is the proxy the requests are sent through. The proxy of the environment is used if empty.
+	ProxyURL string
+	// ClientCertificate and ClientKey are the PEM encoded certificate and key presented to the server (mutual TLS)
+	ClientCertificate []byte
+	ClientKey         []byte
+}
+
+// IsEmpty returns true if the configuration doesn't change the default transport
+func (c TransportConfig) IsEmpty() bool {
+	return len(c.CABundle) == 0 && c.ProxyURL == "" && len(c.ClientCertificate) == 0 && len(c.ClientKey) == 0
+}
+
+// Transport is the option replacing the transport of an http Client with a copy of the default one using the proxy,
+// the CA bundle and the client certificate configured. It must be applied before the options wrapping the transport.
+func Transport(config TransportConfig) ClientOpt {
+	return func(c *http.Client) error {
+		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return errors.New("the default transport can't be configured")
+		}
+		transport := defaultTransport.Clone()
+
+		if config.ProxyURL != "" {
+			proxyURL, err := url.Parse(config.ProxyURL)
+			if err != nil {
+				return fmt.Errorf("invalid proxy URL: %w", err)
+			}
+			if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+				return fmt.Errorf("invalid proxy URL %s: the scheme must be http or https", proxyURL.Redacted())
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if len(config.CABundle) > 0 {
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil {
+				rootCAs = x509.NewCertPool()
+			}
+			if !rootCAs.AppendCertsFromPEM(config.CABundle) {
+				return errors.New("the CA bundle doesn't contain any PEM encoded certificate")
+			}
+			tlsConfig.RootCAs = rootCAs
+		}
+		if len(config.ClientCertificate) > 0 || len(config.ClientKey) > 0 {
+			certificate, err := tls.X509KeyPair(config.ClientCertificate, config.ClientKey)
+			if err != nil {
+				return fmt.Errorf("invalid client certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+		transport.TLSClientConfig = tlsConfig
+
+		c.Transport = transport
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package httputil
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	t.Run("Server signed by the CA bundle is trusted", func(t *testing.T) {
+		httpClient, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, Transport(TransportConfig{CABundle: caBundle}))
+		require.NoError(t, err)
+
+		response, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("Server not signed by the CA bundle is not trusted", func(t *testing.T) {
+		httpClient, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, Transport(TransportConfig{}))
+		require.NoError(t, err)
+
+		_, err = httpClient.Get(server.URL)
+		assert.ErrorContains(t, err, "certificate")
+	})
+
+	t.Run("Requests are sent through the proxy", func(t *testing.T) {
+		var proxied *url.URL
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxy.Close()
+
+		httpClient, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, Transport(TransportConfig{ProxyURL: proxy.URL}))
+		require.NoError(t, err)
+
+		response, err := httpClient.Get("http://cloud.mongodb.com/api/atlas/v1.0/groups")
+		require.NoError(t, err)
+		response.Body.Close()
+		require.NotNil(t, proxied)
+		assert.Equal(t, "cloud.mongodb.com", proxied.Host)
+	})
+
+	t.Run("Invalid configuration", func(t *testing.T) {
+		_, err := DecorateClient(&http.Client{}, Transport(TransportConfig{ProxyURL: "socks5://proxy:1080"}))
+		assert.EqualError(t, err, "invalid proxy URL socks5://proxy:1080: the scheme must be http or https")
+
+		_, err = DecorateClient(&http.Client{}, Transport(TransportConfig{CABundle: []byte("not a certificate")}))
+		assert.EqualError(t, err, "the CA bundle doesn't contain any PEM encoded certificate")
+
+		_, err = DecorateClient(&http.Client{}, Transport(TransportConfig{ClientCertificate: caBundle}))
+		assert.ErrorContains(t, err, "invalid client certificate")
+	})
+}
@@ -0,0 +1,27 @@
+package httputil
+
+import (
+	"net/http"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
+)
+
+// Tracing is the option recording every request sent by an http Client in a span, child of the span in the
+// context of the request
+func Tracing() ClientOpt {
+	return func(c *http.Client) error {
+		c.Transport = &tracedRoundTripper{rt: c.Transport}
+		return nil
+	}
+}
+
+type tracedRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (t *tracedRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, span := tracing.StartHTTP(request.Context(), request, EndpointTemplate(request.URL.Path))
+	response, err := t.rt.RoundTrip(request.WithContext(ctx))
+	tracing.EndHTTP(span, response, err)
+	return response, err
+}
@@ -0,0 +1,48 @@
+package httputil
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/tracing"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	httpClient, err := DecorateClient(&http.Client{Transport: http.DefaultTransport}, Tracing())
+	require.NoError(t, err)
+
+	ctx, parent := tracing.Start(context.Background(), "AtlasProject.Reconcile")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/atlas/v1.0/groups/5f1b3a8c9d2e4f0012345678", nil)
+	require.NoError(t, err)
+	response, err := httpClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	httpSpan := spans[0]
+	assert.Equal(t, "GET /api/atlas/v1.0/groups/{groupId}", httpSpan.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), httpSpan.Parent().SpanID())
+	assert.Equal(t, codes.Error, httpSpan.Status().Code)
+}
@@ -0,0 +1,53 @@
+package keylock
+
+import "sync"
+
+// KeyLock provides a mutual exclusion per key, e.g. to serialize the changes done to the same Atlas project by the
+// reconcilers running concurrently. The locks of the keys nobody holds or waits for are released.
+type KeyLock struct {
+	mu    sync.Mutex
+	locks map[string]*keyMutex
+}
+
+type keyMutex struct {
+	sync.Mutex
+	// refs is the number of the holders and waiters of the lock
+	refs int
+}
+
+func New() *KeyLock {
+	return &KeyLock{locks: map[string]*keyMutex{}}
+}
+
+// Lock blocks until the key is locked and returns the function unlocking it. The KeyLock may be nil, in which case
+// nothing is locked, as well as for the empty key.
+func (l *KeyLock) Lock(key string) func() {
+	if l == nil || key == "" {
+		return func() {}
+	}
+
+	l.mu.Lock()
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &keyMutex{}
+		l.locks[key] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.Lock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			lock.Unlock()
+
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			lock.refs--
+			if lock.refs == 0 {
+				delete(l.locks, key)
+			}
+		})
+	}
+}
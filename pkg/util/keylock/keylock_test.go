@@ -0,0 +1,72 @@
+package keylock
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+	t.Run("Same key is locked exclusively", func(t *testing.T) {
+		locks := New()
+		counter, maxCounter := 0, 0
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer locks.Lock("project")()
+
+				mu.Lock()
+				counter++
+				if counter > maxCounter {
+					maxCounter = counter
+				}
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				counter--
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, maxCounter)
+		assert.Empty(t, locks.locks)
+	})
+	t.Run("Different keys are locked independently", func(t *testing.T) {
+		locks := New()
+		unlock := locks.Lock("first")
+		defer unlock()
+
+		locked := make(chan struct{})
+		go func() {
+			locks.Lock("second")()
+			close(locked)
+		}()
+
+		select {
+		case <-locked:
+		case <-time.After(time.Second):
+			t.Fatal("the second key is blocked by the first one")
+		}
+	})
+	t.Run("Unlocking twice is a no-op", func(t *testing.T) {
+		locks := New()
+		unlock := locks.Lock("project")
+		unlock()
+		unlock()
+
+		assert.Empty(t, locks.locks)
+	})
+	t.Run("Nil lock and empty key don't lock", func(t *testing.T) {
+		var locks *KeyLock
+		locks.Lock("project")()
+		New().Lock("")()
+	})
+}
@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	ServiceName = "mongodb-atlas-kubernetes-operator"
+
+	instrumentationName = "github.com/mongodb/mongodb-atlas-kubernetes"
+)
+
+// Config configures the export of the traces over OTLP
+type Config struct {
+	// Endpoint is the 'host:port' of the OTLP gRPC receiver. The tracing is disabled if it's empty
+	Endpoint string
+	// Insecure disables the TLS when connecting to the receiver
+	Insecure bool
+	// SampleRatio is the ratio of the reconciliations traced, between 0 and 1
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider exporting the spans to the OTLP receiver. The returned function flushes
+// the pending spans and must be called before the process exits.
+// The tracer provider stays a no-op one if the endpoint is not configured.
+func Setup(ctx context.Context, config Config, version string) (func(context.Context) error, error) {
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span of the operator as a child of the span in the context, if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartHTTP starts a client span for an HTTP request
+func StartHTTP(ctx context.Context, request *http.Request, endpoint string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, request.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethod(request.Method),
+			semconv.HTTPRoute(endpoint),
+			semconv.ServerAddress(request.URL.Hostname()),
+		),
+	)
+}
+
+// EndHTTP records the outcome of the HTTP request and ends the span
+func EndHTTP(span trace.Span, response *http.Response, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	span.SetAttributes(semconv.HTTPStatusCode(response.StatusCode))
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+	}
+}
+
+// ResourceAttributes are the attributes identifying the reconciled resource in a span
+func ResourceAttributes(name types.NamespacedName) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", name.Namespace),
+		attribute.String("k8s.resource.name", name.Name),
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasproject,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasprojects,verbs=create;update,versions=v1,name=matlasproject.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasdeployment,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdeployments,verbs=create;update,versions=v1,name=matlasdeployment.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-atlas-mongodb-com-v1-atlasdatabaseuser,mutating=true,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=create;update,versions=v1,name=matlasdatabaseuser.atlas.mongodb.com,admissionReviewVersions=v1
+
+const defaultDatabaseName = "admin"
+
+// defaultFunc brings a single resource of a known type to its canonical form
+type defaultFunc func(ctx context.Context, obj runtime.Object) error
+
+// defaulter implements admission.CustomDefaulter storing resources in the same form the operator
+// sends them to Atlas, so the object in the cluster doesn't differ from the applied configuration.
+type defaulter struct {
+	apply defaultFunc
+}
+
+var _ admission.CustomDefaulter = &defaulter{}
+
+func (d *defaulter) Default(ctx context.Context, obj runtime.Object) error {
+	return d.apply(ctx, obj)
+}
+
+func defaultProject(_ context.Context, obj runtime.Object) error {
+	project, ok := obj.(*mdbv1.AtlasProject)
+	if !ok {
+		return unexpectedType("AtlasProject", obj)
+	}
+
+	for i := range project.Spec.ProjectIPAccessList {
+		project.Spec.ProjectIPAccessList[i] = project.Spec.ProjectIPAccessList[i].Normalize()
+	}
+
+	return nil
+}
+
+func defaultDeployment(_ context.Context, obj runtime.Object) error {
+	deployment, ok := obj.(*mdbv1.AtlasDeployment)
+	if !ok {
+		return unexpectedType("AtlasDeployment", obj)
+	}
+
+	deployment.Normalize()
+
+	return nil
+}
+
+func defaultDatabaseUser(_ context.Context, obj runtime.Object) error {
+	user, ok := obj.(*mdbv1.AtlasDatabaseUser)
+	if !ok {
+		return unexpectedType("AtlasDatabaseUser", obj)
+	}
+
+	if user.Spec.DatabaseName == "" {
+		user.Spec.DatabaseName = defaultDatabaseName
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/provider"
+)
+
+func TestDefaulter(t *testing.T) {
+	t.Run("single address CIDR blocks are collapsed to IP addresses", func(t *testing.T) {
+		d := &defaulter{apply: defaultProject}
+		atlasProject := mdbv1.DefaultProject("default", "secret").
+			WithIPAccessList(project.NewIPAccessList().WithCIDR("192.168.0.1/32")).
+			WithIPAccessList(project.NewIPAccessList().WithCIDR("192.168.0.0/24")).
+			WithIPAccessList(project.NewIPAccessList().WithCIDR("2001:db8::/32"))
+
+		assert.NoError(t, d.Default(context.Background(), atlasProject))
+		assert.Equal(
+			t,
+			[]project.IPAccessList{
+				{IPAddress: "192.168.0.1"},
+				{CIDRBlock: "192.168.0.0/24"},
+				{CIDRBlock: "2001:db8::/32"},
+			},
+			atlasProject.Spec.ProjectIPAccessList,
+		)
+	})
+
+	t.Run("shared tier deployment is normalized", func(t *testing.T) {
+		d := &defaulter{apply: defaultDeployment}
+		deployment := mdbv1.DefaultAWSDeployment("default", "project").WithInstanceSize("m0")
+
+		assert.NoError(t, d.Default(context.Background(), deployment))
+		assert.Equal(t, "M0", deployment.Spec.DeploymentSpec.ProviderSettings.InstanceSizeName)
+		assert.Equal(t, provider.ProviderTenant, deployment.Spec.DeploymentSpec.ProviderSettings.ProviderName)
+		assert.Equal(t, "AWS", deployment.Spec.DeploymentSpec.ProviderSettings.BackingProviderName)
+	})
+
+	t.Run("database user gets the default database name", func(t *testing.T) {
+		d := &defaulter{apply: defaultDatabaseUser}
+		user := mdbv1.DefaultDBUser("default", "user", "project")
+		user.Spec.DatabaseName = ""
+
+		assert.NoError(t, d.Default(context.Background(), user))
+		assert.Equal(t, "admin", user.Spec.DatabaseName)
+	})
+
+	t.Run("unexpected type is rejected", func(t *testing.T) {
+		d := &defaulter{apply: defaultDatabaseUser}
+
+		assert.Error(t, d.Default(context.Background(), &mdbv1.AtlasProject{}))
+	})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/validate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/kube"
+)
+
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasproject,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasprojects,verbs=create;update,versions=v1,name=vatlasproject.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasdeployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdeployments,verbs=create;update,versions=v1,name=vatlasdeployment.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasdatabaseuser,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=create;update,versions=v1,name=vatlasdatabaseuser.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasbackupschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasbackupschedules,verbs=create;update,versions=v1,name=vatlasbackupschedule.atlas.mongodb.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-atlas-mongodb-com-v1-atlasdatafederation,mutating=false,failurePolicy=fail,sideEffects=None,groups=atlas.mongodb.com,resources=atlasdatafederations,verbs=create;update,versions=v1,name=vatlasdatafederation.atlas.mongodb.com,admissionReviewVersions=v1
+
+// validateFunc runs the validation of a single resource of a known type
+type validateFunc func(ctx context.Context, obj runtime.Object) error
+
+// validator implements admission.CustomValidator running the same checks the reconcilers do
+// on create and update. Deletions are always allowed.
+type validator struct {
+	validate validateFunc
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return v.validate(ctx, obj)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) error {
+	return v.validate(ctx, newObj)
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) error {
+	return nil
+}
+
+func validateProject(_ context.Context, obj runtime.Object) error {
+	project, ok := obj.(*mdbv1.AtlasProject)
+	if !ok {
+		return unexpectedType("AtlasProject", obj)
+	}
+
+	return validate.Project(project)
+}
+
+func validateDeployment(_ context.Context, obj runtime.Object) error {
+	deployment, ok := obj.(*mdbv1.AtlasDeployment)
+	if !ok {
+		return unexpectedType("AtlasDeployment", obj)
+	}
+
+	return validate.DeploymentSpec(deployment.Spec)
+}
+
+func validateDatabaseUser(_ context.Context, obj runtime.Object) error {
+	user, ok := obj.(*mdbv1.AtlasDatabaseUser)
+	if !ok {
+		return unexpectedType("AtlasDatabaseUser", obj)
+	}
+
+	return validate.DatabaseUser(user)
+}
+
+func validateDataFederation(_ context.Context, obj runtime.Object) error {
+	dataFederation, ok := obj.(*mdbv1.AtlasDataFederation)
+	if !ok {
+		return unexpectedType("AtlasDataFederation", obj)
+	}
+
+	return validate.DataFederation(dataFederation)
+}
+
+// validateBackupSchedule checks the schedule against every deployment referencing it.
+// A schedule that is not referenced by any deployment yet is only checked on its own.
+func validateBackupSchedule(kubeClient client.Client) validateFunc {
+	return func(ctx context.Context, obj runtime.Object) error {
+		bSchedule, ok := obj.(*mdbv1.AtlasBackupSchedule)
+		if !ok {
+			return unexpectedType("AtlasBackupSchedule", obj)
+		}
+
+		scheduleKey := kube.ObjectKeyFromObject(bSchedule)
+		deployments := &mdbv1.AtlasDeploymentList{}
+		if err := kubeClient.List(ctx, deployments, client.MatchingFields{watch.DeploymentBackupScheduleIndex: scheduleKey.String()}); err != nil {
+			return fmt.Errorf("failed to list deployments referencing the backup schedule: %w", err)
+		}
+
+		var err error
+		for i := range deployments.Items {
+			deployment := &deployments.Items[i]
+			if validationErr := validate.BackupSchedule(bSchedule, deployment); validationErr != nil {
+				err = errors.Join(err, fmt.Errorf("deployment %s: %w", kube.ObjectKeyFromObject(deployment), validationErr))
+			}
+		}
+
+		if len(deployments.Items) == 0 {
+			return validate.BackupSchedule(bSchedule, nil)
+		}
+
+		return err
+	}
+}
+
+func unexpectedType(kind string, obj runtime.Object) error {
+	return apierrors.NewBadRequest(fmt.Sprintf("expected an %s but got %T", kind, obj))
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/toptr"
+)
+
+func TestValidator(t *testing.T) {
+	t.Run("project with invalid ip access list is rejected", func(t *testing.T) {
+		v := &validator{validate: validateProject}
+		atlasProject := mdbv1.DefaultProject("default", "secret").WithIPAccessList(project.IPAccessList{CIDRBlock: "not-a-cidr"})
+
+		assert.ErrorContains(t, v.ValidateCreate(context.Background(), atlasProject), "invalid cidrBlock")
+		assert.ErrorContains(t, v.ValidateUpdate(context.Background(), &mdbv1.AtlasProject{}, atlasProject), "invalid cidrBlock")
+		assert.NoError(t, v.ValidateDelete(context.Background(), atlasProject))
+	})
+
+	t.Run("valid project is accepted", func(t *testing.T) {
+		v := &validator{validate: validateProject}
+
+		assert.NoError(t, v.ValidateCreate(context.Background(), mdbv1.DefaultProject("default", "secret")))
+	})
+
+	t.Run("deployment with more than one spec is rejected", func(t *testing.T) {
+		v := &validator{validate: validateDeployment}
+		deployment := mdbv1.DefaultAWSDeployment("default", "project")
+		deployment.Spec.AdvancedDeploymentSpec = &mdbv1.AdvancedDeploymentSpec{}
+
+		assert.ErrorContains(t, v.ValidateCreate(context.Background(), deployment), "expected exactly one of")
+	})
+
+	t.Run("unexpected type is rejected", func(t *testing.T) {
+		v := &validator{validate: validateDeployment}
+
+		assert.ErrorContains(t, v.ValidateCreate(context.Background(), &mdbv1.AtlasProject{}), "expected an AtlasDeployment")
+	})
+
+	t.Run("data federation without name is rejected", func(t *testing.T) {
+		v := &validator{validate: validateDataFederation}
+
+		assert.ErrorContains(t, v.ValidateCreate(context.Background(), mdbv1.NewDataFederationInstance("project", "", "default")), "spec.name must be set")
+	})
+}
+
+func TestValidateBackupSchedule(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(mdbv1.AddToScheme(scheme))
+
+	bSchedule := &mdbv1.AtlasBackupSchedule{
+		Spec: mdbv1.AtlasBackupScheduleSpec{
+			CopySettings: []mdbv1.CopySetting{
+				{
+					RegionName:        toptr.MakePtr("US_EAST_1"),
+					ReplicationSpecID: toptr.MakePtr("123456"),
+				},
+			},
+		},
+	}
+	bSchedule.Name = "schedule"
+	bSchedule.Namespace = "default"
+
+	t.Run("schedule without deployments is checked on its own", func(t *testing.T) {
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme).
+			WithIndex(&mdbv1.AtlasDeployment{}, watch.DeploymentBackupScheduleIndex, watch.DeploymentBackupScheduleRefs).
+			Build()
+		v := &validator{validate: validateBackupSchedule(kubeClient)}
+
+		assert.NoError(t, v.ValidateCreate(context.Background(), bSchedule))
+	})
+
+	t.Run("schedule is checked against referencing deployments", func(t *testing.T) {
+		deployment := mdbv1.DefaultAWSDeployment("default", "project").
+			WithBackupScheduleRef(common.ResourceRefNamespaced{Name: "schedule"})
+		deployment.Status.ReplicaSets = []status.ReplicaSet{{ID: "other"}}
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).
+			WithIndex(&mdbv1.AtlasDeployment{}, watch.DeploymentBackupScheduleIndex, watch.DeploymentBackupScheduleRefs).
+			Build()
+		v := &validator{validate: validateBackupSchedule(kubeClient)}
+
+		assert.ErrorContains(t, v.ValidateCreate(context.Background(), bSchedule), "referenced ReplicationSpecID is invalid")
+	})
+
+	t.Run("schedule is not checked against other deployments", func(t *testing.T) {
+		deployment := mdbv1.DefaultAWSDeployment("default", "project").
+			WithBackupScheduleRef(common.ResourceRefNamespaced{Name: "other-schedule"})
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).
+			WithIndex(&mdbv1.AtlasDeployment{}, watch.DeploymentBackupScheduleIndex, watch.DeploymentBackupScheduleRefs).
+			Build()
+		v := &validator{validate: validateBackupSchedule(kubeClient)}
+
+		assert.NoError(t, v.ValidateCreate(context.Background(), bSchedule))
+	})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (C) MongoDB, Inc. 2020-present.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may
+not use this file except in compliance with the License. You may obtain
+a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+*/
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
+)
+
+// Setup registers the admission webhooks of the Atlas custom resources in the manager webhook server.
+// The conversion webhook is registered as well for the resources having more than one version in the manager scheme.
+func Setup(mgr ctrl.Manager) error {
+	webhooks := []struct {
+		apiType   runtime.Object
+		validator admission.CustomValidator
+		defaulter admission.CustomDefaulter
+	}{
+		{
+			apiType:   &mdbv1.AtlasProject{},
+			validator: &validator{validate: validateProject},
+			defaulter: &defaulter{apply: defaultProject},
+		},
+		{
+			apiType:   &mdbv1.AtlasDeployment{},
+			validator: &validator{validate: validateDeployment},
+			defaulter: &defaulter{apply: defaultDeployment},
+		},
+		{
+			apiType:   &mdbv1.AtlasDatabaseUser{},
+			validator: &validator{validate: validateDatabaseUser},
+			defaulter: &defaulter{apply: defaultDatabaseUser},
+		},
+		{
+			apiType:   &mdbv1.AtlasBackupSchedule{},
+			validator: &validator{validate: validateBackupSchedule(mgr.GetClient())},
+		},
+		{
+			apiType:   &mdbv1.AtlasDataFederation{},
+			validator: &validator{validate: validateDataFederation},
+		},
+	}
+
+	for _, w := range webhooks {
+		builder := ctrl.NewWebhookManagedBy(mgr).For(w.apiType).WithValidator(w.validator)
+		if w.defaulter != nil {
+			builder = builder.WithDefaulter(w.defaulter)
+		}
+
+		if err := builder.Complete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
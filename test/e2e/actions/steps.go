@@ -16,7 +16,6 @@ import (
 
 	v1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1/status"
-	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdeployment"
 	kube "github.com/mongodb/mongodb-atlas-kubernetes/test/e2e/actions/kube"
 	"github.com/mongodb/mongodb-atlas-kubernetes/test/e2e/api/atlas"
 	appclient "github.com/mongodb/mongodb-atlas-kubernetes/test/e2e/appclient"
@@ -227,7 +226,7 @@ func CompareAdvancedDeploymentsSpec(requested model.DeploymentSpec, created mong
 
 	if advancedSpec == nil {
 		converted := v1.AtlasDeploymentSpec(requested)
-		err := atlasdeployment.ConvertLegacyDeployment(&converted)
+		err := v1.ConvertLegacyDeployment(&converted)
 		Expect(err).ShouldNot(HaveOccurred())
 		converted.DeploymentSpec = nil
 		advancedSpec = converted.AdvancedDeploymentSpec
@@ -29,6 +29,7 @@ import (
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasproject"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
@@ -102,49 +103,48 @@ func BuildManager(initCfg *Config) (manager.Manager, error) {
 		watch.SelectNamespacesPredicate(config.WatchedNamespaces), // select only desired namespaces
 	}
 
-	if err = (&atlasdeployment.AtlasDeploymentReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-		Scheme:                      mgr.GetScheme(),
+	liveSettings := atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{
 		AtlasDomain:                 config.AtlasDomain,
-		GlobalAPISecret:             config.GlobalAPISecret,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDeployment"),
 		ObjectDeletionProtection:    config.ObjectDeletionProtection,
 		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+	})
+
+	if err = (&atlasdeployment.AtlasDeploymentReconciler{
+		Client:           mgr.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasDeployment").Sugar(),
+		Scheme:           mgr.GetScheme(),
+		Settings:         liveSettings,
+		GlobalAPISecret:  config.GlobalAPISecret,
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    mgr.GetEventRecorderFor("AtlasDeployment"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDeployment")
 		return nil, err
 	}
 
 	if err = (&atlasproject.AtlasProjectReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasProject").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             config.GlobalAPISecret,
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasProject"),
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:           mgr.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasProject").Sugar(),
+		Scheme:           mgr.GetScheme(),
+		Settings:         liveSettings,
+		GlobalAPISecret:  config.GlobalAPISecret,
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    mgr.GetEventRecorderFor("AtlasProject"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasProject")
 		return nil, err
 	}
 
 	if err = (&atlasdatabaseuser.AtlasDatabaseUserReconciler{
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		AtlasDomain:                 config.AtlasDomain,
-		GlobalAPISecret:             config.GlobalAPISecret,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDatabaseUser"),
-		GlobalPredicates:            globalPredicates,
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:            mgr.GetClient(),
+		Log:               logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
+		Scheme:            mgr.GetScheme(),
+		Settings:          liveSettings,
+		GlobalAPISecret:   config.GlobalAPISecret,
+		EventRecorder:     mgr.GetEventRecorderFor("AtlasDatabaseUser"),
+		GlobalPredicates:  globalPredicates,
+		APIReader:         mgr.GetAPIReader(),
+		WatchedNamespaces: config.WatchedNamespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDatabaseUser")
 		return nil, err
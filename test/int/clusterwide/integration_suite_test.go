@@ -46,6 +46,7 @@ import (
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasproject"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
 	// +kubebuilder:scaffold:imports
@@ -127,11 +128,12 @@ var _ = BeforeSuite(func() {
 			}),
 		}
 
+		liveSettings := atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{AtlasDomain: atlasDomain})
+
 		err = (&atlasproject.AtlasProjectReconciler{
 			Client:           k8sManager.GetClient(),
 			Log:              logger.Named("controllers").Named("AtlasProject").Sugar(),
-			AtlasDomain:      atlasDomain,
-			ResourceWatcher:  watch.NewResourceWatcher(),
+			Settings:         liveSettings,
 			GlobalPredicates: globalPredicates,
 			EventRecorder:    k8sManager.GetEventRecorderFor("AtlasProject"),
 		}).SetupWithManager(k8sManager)
@@ -140,7 +142,7 @@ var _ = BeforeSuite(func() {
 		err = (&atlasdeployment.AtlasDeploymentReconciler{
 			Client:           k8sManager.GetClient(),
 			Log:              logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-			AtlasDomain:      atlasDomain,
+			Settings:         liveSettings,
 			GlobalPredicates: globalPredicates,
 			EventRecorder:    k8sManager.GetEventRecorderFor("AtlasDeployment"),
 		}).SetupWithManager(k8sManager)
@@ -149,10 +151,10 @@ var _ = BeforeSuite(func() {
 		err = (&atlasdatabaseuser.AtlasDatabaseUserReconciler{
 			Client:           k8sManager.GetClient(),
 			Log:              logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-			AtlasDomain:      atlasDomain,
+			Settings:         liveSettings,
 			GlobalPredicates: globalPredicates,
 			EventRecorder:    k8sManager.GetEventRecorderFor("AtlasDeployment"),
-			ResourceWatcher:  watch.NewResourceWatcher(),
+			APIReader:        k8sManager.GetAPIReader(),
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -148,7 +148,7 @@ var _ = Describe("AtlasDeployment", Label("int", "AtlasDeployment", "deployment-
 			legacyDeployment := createdDeployment.Spec.DeploymentSpec
 
 			if legacyDeployment != nil {
-				err := atlasdeployment.ConvertLegacyDeployment(&createdDeployment.Spec)
+				err := mdbv1.ConvertLegacyDeployment(&createdDeployment.Spec)
 				Expect(err).ToNot(HaveOccurred())
 
 				createdDeployment.Spec.DeploymentSpec = nil
@@ -409,7 +409,7 @@ var _ = Describe("AtlasDeployment", Label("int", "AtlasDeployment", "deployment-
 			createdDeployment.Spec.DeploymentSpec.DiskSizeGB = intptr(10)
 
 			replicationSpecsCheckFunc := func(c *mongodbatlas.AdvancedCluster) {
-				err := atlasdeployment.ConvertLegacyDeployment(&createdDeployment.Spec)
+				err := mdbv1.ConvertLegacyDeployment(&createdDeployment.Spec)
 				Expect(err).ToNot(HaveOccurred())
 
 				mergedDeployment, _, err := atlasdeployment.MergedAdvancedDeployment(*c, *createdDeployment.Spec.AdvancedDeploymentSpec)
@@ -52,6 +52,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasoperatorconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/atlasproject"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/pkg/util/httputil"
@@ -226,55 +227,50 @@ func prepareControllers(deletionProtection bool) (*corev1.Namespace, context.Can
 		}),
 	}
 
-	err = (&atlasproject.AtlasProjectReconciler{
-		Client:                      k8sManager.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasProject").Sugar(),
+	liveSettings := atlasoperatorconfig.NewLiveSettings(atlasoperatorconfig.Settings{
 		AtlasDomain:                 atlasDomain,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               k8sManager.GetEventRecorderFor("AtlasProject"),
 		ObjectDeletionProtection:    deletionProtection,
 		SubObjectDeletionProtection: deletionProtection,
+	})
+
+	err = (&atlasproject.AtlasProjectReconciler{
+		Client:           k8sManager.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasProject").Sugar(),
+		Settings:         liveSettings,
+		GlobalAPISecret:  kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    k8sManager.GetEventRecorderFor("AtlasProject"),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&atlasdeployment.AtlasDeploymentReconciler{
-		Client:                      k8sManager.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-		AtlasDomain:                 atlasDomain,
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               k8sManager.GetEventRecorderFor("AtlasDeployment"),
-		ObjectDeletionProtection:    deletionProtection,
-		SubObjectDeletionProtection: deletionProtection,
+		Client:           k8sManager.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasDeployment").Sugar(),
+		Settings:         liveSettings,
+		GlobalAPISecret:  kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    k8sManager.GetEventRecorderFor("AtlasDeployment"),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&atlasdatabaseuser.AtlasDatabaseUserReconciler{
-		Client:                      k8sManager.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
-		AtlasDomain:                 atlasDomain,
-		EventRecorder:               k8sManager.GetEventRecorderFor("AtlasDatabaseUser"),
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
-		GlobalPredicates:            globalPredicates,
-		ObjectDeletionProtection:    deletionProtection,
-		SubObjectDeletionProtection: deletionProtection,
+		Client:           k8sManager.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
+		Settings:         liveSettings,
+		EventRecorder:    k8sManager.GetEventRecorderFor("AtlasDatabaseUser"),
+		GlobalAPISecret:  kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
+		GlobalPredicates: globalPredicates,
+		APIReader:        k8sManager.GetAPIReader(),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&atlasdatafederation.AtlasDataFederationReconciler{
-		Client:                      k8sManager.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDataFederation").Sugar(),
-		AtlasDomain:                 atlasDomain,
-		EventRecorder:               k8sManager.GetEventRecorderFor("AtlasDatabaseUser"),
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalAPISecret:             kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
-		GlobalPredicates:            globalPredicates,
-		ObjectDeletionProtection:    deletionProtection,
-		SubObjectDeletionProtection: deletionProtection,
+		Client:           k8sManager.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasDataFederation").Sugar(),
+		Settings:         liveSettings,
+		EventRecorder:    k8sManager.GetEventRecorderFor("AtlasDatabaseUser"),
+		GlobalAPISecret:  kube.ObjectKey(namespace.Name, "atlas-operator-api-key"),
+		GlobalPredicates: globalPredicates,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 